@@ -0,0 +1,31 @@
+data "awsteam_identity_center_users" "jane" {
+  user_name = "jane@contoso.com"
+}
+
+data "awsteam_identity_center_groups" "platform" {
+  display_name = "Platform"
+}
+
+data "awsteam_permission_sets" "admin" {
+  name = "AdministratorAccess"
+}
+
+// Can jane, a member of the Platform group, request AdministratorAccess on the
+// production account for 4 hours, and who would approve it?
+data "awsteam_access_evaluation" "jane_admin_production" {
+  user_id        = data.awsteam_identity_center_users.jane.users[0].id
+  group_ids      = [data.awsteam_identity_center_groups.platform.groups[0].id]
+  account_id     = "123456789012"
+  permission_arn = data.awsteam_permission_sets.admin.by_name["AdministratorAccess"].arn
+  duration       = 4
+}
+
+output "jane_admin_production" {
+  value = {
+    allowed           = data.awsteam_access_evaluation.jane_admin_production.allowed
+    approval_required = data.awsteam_access_evaluation.jane_admin_production.approval_required
+    max_duration      = data.awsteam_access_evaluation.jane_admin_production.max_duration
+    approvers         = data.awsteam_access_evaluation.jane_admin_production.approvers.*.group_name
+    reason            = data.awsteam_access_evaluation.jane_admin_production.reason
+  }
+}
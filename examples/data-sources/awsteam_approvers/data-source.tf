@@ -0,0 +1,8 @@
+data "awsteam_approvers" "production" {
+  account_id = "123456789012"
+}
+
+// The approver groups and the account or OU policy each one comes from
+output "production_approvers" {
+  value = [for a in data.awsteam_approvers.production.approvers : "${a.group_name} (${a.source_type} ${a.source_name})"]
+}
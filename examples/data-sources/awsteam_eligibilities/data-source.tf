@@ -0,0 +1,18 @@
+// Who can elevate into an account with AdministratorAccess?
+data "awsteam_permission_sets" "admin" {
+  name = "AdministratorAccess"
+}
+
+data "awsteam_eligibilities" "admins" {
+  account_id     = "123456789012"
+  permission_arn = data.awsteam_permission_sets.admin.by_name["AdministratorAccess"].arn
+}
+
+output "admin_principals" {
+  value = [for e in data.awsteam_eligibilities.admins.eligibilities : "${e.type}: ${e.name}"]
+}
+
+// All eligibility policies of groups
+data "awsteam_eligibilities" "groups" {
+  principal_type = "Group"
+}
@@ -0,0 +1,12 @@
+// Read the eligibility policy of a group managed in another configuration
+data "awsteam_identity_center_groups" "admins" {
+  display_name = "my-group@contoso.com"
+}
+
+data "awsteam_eligibility" "admins" {
+  id = data.awsteam_identity_center_groups.admins.groups[0].id
+}
+
+output "admin_accounts" {
+  value = data.awsteam_eligibility.admins.accounts.*.account_id
+}
@@ -0,0 +1,18 @@
+data "awsteam_identity_center_groups" "approvers" {
+  display_name = "my-group-approvers@contoso.com"
+}
+
+// Use the id and display name of the group in an approvers policy
+resource "awsteam_approvers_account" "example" {
+  account_id   = "123456789012"
+  account_name = "My-aws-account"
+  approvers    = [data.awsteam_identity_center_groups.approvers.groups[0].display_name]
+  group_ids    = [data.awsteam_identity_center_groups.approvers.groups[0].id]
+}
+
+// Look up any group by its display name
+data "awsteam_identity_center_groups" "all" {}
+
+output "admins_group_id" {
+  value = data.awsteam_identity_center_groups.all.by_display_name["Admins"].id
+}
@@ -0,0 +1,32 @@
+// Look up a user by user name
+data "awsteam_identity_center_users" "jane" {
+  user_name = "jane.doe@contoso.com"
+}
+
+resource "awsteam_eligibility_user" "example" {
+  user_name         = data.awsteam_identity_center_users.jane.users[0].user_name
+  user_id           = data.awsteam_identity_center_users.jane.users[0].id
+  approval_required = true
+  duration          = 5
+  accounts = [
+    {
+      account_id   = "123456789012"
+      account_name = "My-aws-account"
+    }
+  ]
+  permissions = [
+    {
+      permission_arn  = "arn:aws:sso:::permissionSet/ssoins-4334d1f197f50907/ps-f5ge203d3d2428d3"
+      permission_name = "elevated-permission"
+    }
+  ]
+}
+
+// Look up a user by email address
+data "awsteam_identity_center_users" "john" {
+  email = "john.doe@contoso.com"
+}
+
+output "john_user_id" {
+  value = data.awsteam_identity_center_users.john.users[0].id
+}
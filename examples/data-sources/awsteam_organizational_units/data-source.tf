@@ -0,0 +1,19 @@
+data "awsteam_organizational_units" "all" {}
+
+// Look up an organizational unit by its name
+resource "awsteam_approvers_ou" "sandbox" {
+  ou_id     = data.awsteam_organizational_units.all.by_name["Sandbox"].id
+  ou_name   = data.awsteam_organizational_units.all.by_name["Sandbox"].name
+  approvers = ["my-group-approvers@contoso.com"]
+  group_ids = ["d78686b5-bb78-471c-8b2f-817e70e3158b"]
+}
+
+// Names used more than once can be looked up by their path from the root
+output "workloads_prod_id" {
+  value = data.awsteam_organizational_units.all.by_path["/Workloads/Prod"].id
+}
+
+// Access the ids of all organizational units below the root
+output "ou_ids" {
+  value = [for ou in data.awsteam_organizational_units.all.organizational_units : ou.id if ou.parent_id != null]
+}
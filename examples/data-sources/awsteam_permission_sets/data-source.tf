@@ -0,0 +1,30 @@
+data "awsteam_permission_sets" "all" {}
+
+// Reference a permission set by name in an eligibility policy
+resource "awsteam_eligibility_group" "example" {
+  group_name        = "my-group@contoso.com"
+  group_id          = "d78686b5-bb78-471c-8b2f-817e70e3158b"
+  approval_required = true
+  duration          = 5
+  accounts = [
+    {
+      account_id   = "123456789012"
+      account_name = "My-aws-account"
+    }
+  ]
+  permissions = [
+    {
+      permission_arn  = data.awsteam_permission_sets.all.by_name["ReadOnly"].arn
+      permission_name = data.awsteam_permission_sets.all.by_name["ReadOnly"].name
+    }
+  ]
+}
+
+// Only return the permission sets whose name starts with "Elevated"
+data "awsteam_permission_sets" "elevated" {
+  name_regex = "^Elevated"
+}
+
+output "elevated_permission_set_arns" {
+  value = data.awsteam_permission_sets.elevated.permission_sets.*.arn
+}
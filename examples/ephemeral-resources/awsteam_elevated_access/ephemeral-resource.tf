@@ -0,0 +1,9 @@
+ephemeral "awsteam_elevated_access" "example" {
+  user_name      = "jane.doe@contoso.com"
+  email          = "jane.doe@contoso.com"
+  account_id     = "123456789011"
+  permission_arn = "arn:aws:sso:::permissionSet/ssoins-4334d1f197f50907/ps-f5ge203d3d2428d3"
+  duration       = 1
+  justification  = "Terraform deployment pipeline"
+  timeout        = 600
+}
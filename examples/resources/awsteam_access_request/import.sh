@@ -0,0 +1,2 @@
+# Import using the request id
+terraform import awsteam_access_request.example 0f3c1d5e-4c52-4d0e-9c7a-5d0b6b1a2f3e
\ No newline at end of file
@@ -0,0 +1,9 @@
+resource "awsteam_access_request" "example" {
+  user_name      = "jane.doe@contoso.com"
+  email          = "jane.doe@contoso.com"
+  account_id     = "123456789011"
+  permission_arn = "arn:aws:sso:::permissionSet/ssoins-4334d1f197f50907/ps-f5ge203d3d2428d3"
+  duration       = 2
+  justification  = "Investigate failing deployment"
+  ticket_no      = "INC-1234"
+}
@@ -34,7 +34,7 @@ func RunSerialTests2Levels(t *testing.T, testCases map[string]map[string]func(t
 	}
 }
 
-func NewAWSTeamClient(ctx context.Context) *awsteam.Client {
+func NewAWSTeamClient(ctx context.Context) (*awsteam.Client, error) {
 	clientId := os.Getenv(envvar.AWSTEAMClientId)
 	clientSecret := os.Getenv(envvar.AWSTEAMClientSecret)
 	graphEndpoint := os.Getenv(envvar.AWSTEAMGraphEndpoint)
@@ -47,7 +47,9 @@ func NewAWSTeamClient(ctx context.Context) *awsteam.Client {
 		TokenEndpoint: TokenEndpoint,
 	}
 
-	config.Build(ctx)
+	if err := config.Build(ctx); err != nil {
+		return nil, err
+	}
 
 	return config.NewClient(ctx)
 }
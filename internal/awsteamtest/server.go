@@ -0,0 +1,589 @@
+// Package awsteamtest provides an in-memory AWS TEAM deployment for tests.
+//
+// A Server answers the OAuth2 token endpoint and the GraphQL endpoint of a
+// TEAM deployment from local state, so the SDK and the provider can be tested
+// without a deployment or Cognito credentials. It implements the settings,
+// eligibility, approvers, account and organizational unit operations, and the
+// Identity Center user and group lookups. Other operations fail with a validation error.
+package awsteamtest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/aws/smithy-go/ptr"
+	"github.com/awsteam-contrib/terraform-provider-awsteam/internal/sdk/awsteam"
+)
+
+const (
+	// ClientId and ClientSecret are the only credentials the token endpoint
+	// accepts.
+	ClientId     = "awsteamtest-client"
+	ClientSecret = "awsteamtest-secret"
+
+	accessToken = "awsteamtest-token"
+
+	// The default page size of list operations, as in AppSync.
+	defaultLimit = 100
+
+	settingsId = "settings"
+)
+
+// Server is a fake TEAM deployment. Its zero value is not usable; create one
+// with NewServer.
+type Server struct {
+	// The URLs of the token and graph endpoints.
+	TokenEndpoint string
+	GraphEndpoint string
+
+	server *httptest.Server
+
+	mu            sync.Mutex
+	settings      *awsteam.Settings
+	eligibilities map[string]*awsteam.Eligibility
+	approvers     map[string]*awsteam.Approvers
+	accounts      []*awsteam.Account
+	root          *awsteam.OU
+	parents       map[string]string
+	users         []*awsteam.IdCUser
+	groups        []*awsteam.IdCGroup
+}
+
+// NewServer starts a Server without any data. Close it when done.
+func NewServer() *Server {
+	s := &Server{
+		eligibilities: map[string]*awsteam.Eligibility{},
+		approvers:     map[string]*awsteam.Approvers{},
+		parents:       map[string]string{},
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/oauth2/token", s.handleToken)
+	mux.HandleFunc("/graphql", s.handleGraph)
+
+	s.server = httptest.NewServer(mux)
+	s.TokenEndpoint = s.server.URL + "/oauth2/token"
+	s.GraphEndpoint = s.server.URL + "/graphql"
+
+	return s
+}
+
+// Close shuts the server down.
+func (s *Server) Close() {
+	s.server.Close()
+}
+
+// Config returns a configuration for a client of the server.
+func (s *Server) Config() *awsteam.Config {
+	return &awsteam.Config{
+		ClientId:      ClientId,
+		ClientSecret:  ClientSecret,
+		GraphEndpoint: s.GraphEndpoint,
+		TokenEndpoint: s.TokenEndpoint,
+	}
+}
+
+// AddAccounts adds accounts to those returned by getAccounts.
+func (s *Server) AddAccounts(accounts ...*awsteam.Account) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.accounts = append(s.accounts, accounts...)
+}
+
+// SetOUs sets the organization tree returned by getOUs.
+func (s *Server) SetOUs(root *awsteam.OU) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.root = clone(root)
+}
+
+// SetParent sets the OU or root returned by getOU for the account.
+func (s *Server) SetParent(accountId string, parentId string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.parents[accountId] = parentId
+}
+
+// AddUsers adds users to those returned by getUsers.
+func (s *Server) AddUsers(users ...*awsteam.IdCUser) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.users = append(s.users, users...)
+}
+
+// AddGroups adds groups to those returned by getIdCGroups.
+func (s *Server) AddGroups(groups ...*awsteam.IdCGroup) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.groups = append(s.groups, groups...)
+}
+
+// Settings returns a copy of the stored settings, or nil if there are none.
+func (s *Server) Settings() *awsteam.Settings {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return clone(s.settings)
+}
+
+// Eligibility returns a copy of the stored eligibility policy with the given
+// id, or nil if there is none.
+func (s *Server) Eligibility(id string) *awsteam.Eligibility {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return clone(s.eligibilities[id])
+}
+
+// Approvers returns a copy of the stored approvers policy with the given id,
+// or nil if there is none.
+func (s *Server) Approvers(id string) *awsteam.Approvers {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return clone(s.approvers[id])
+}
+
+func (s *Server) handleToken(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil || r.Method != http.MethodPost {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_request"})
+		return
+	}
+
+	if r.PostForm.Get("grant_type") != "client_credentials" {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "unsupported_grant_type"})
+		return
+	}
+
+	if r.PostForm.Get("client_id") != ClientId || r.PostForm.Get("client_secret") != ClientSecret {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_client", "error_description": "Client authentication failed"})
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"access_token": accessToken,
+		"expires_in":   3600,
+		"token_type":   "Bearer",
+	})
+}
+
+// graphError is an element of the errors array of a GraphQL response, shaped
+// like those AppSync returns.
+type graphError struct {
+	ErrorType string   `json:"errorType"`
+	Message   string   `json:"message"`
+	Path      []string `json:"path,omitempty"`
+}
+
+var operationPattern = regexp.MustCompile(`^\s*(?:query|mutation)\s+(\w+)`)
+
+func (s *Server) handleGraph(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get("Authorization") != "Bearer "+accessToken {
+		writeJSON(w, http.StatusUnauthorized, map[string]interface{}{
+			"errors": []graphError{{ErrorType: "UnauthorizedException", Message: "Valid authorization header not provided."}},
+		})
+		return
+	}
+
+	var req struct {
+		Query     string                     `json:"query"`
+		Variables map[string]json.RawMessage `json:"variables"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSON(w, http.StatusBadRequest, map[string]interface{}{
+			"errors": []graphError{{ErrorType: "MalformedHttpRequestException", Message: err.Error()}},
+		})
+		return
+	}
+
+	match := operationPattern.FindStringSubmatch(req.Query)
+	operation := ""
+
+	if match != nil {
+		operation = match[1]
+	}
+
+	field, data, gqlErr := s.execute(operation, req.Variables)
+
+	if gqlErr != nil {
+		if gqlErr.Path == nil && field != "" {
+			gqlErr.Path = []string{field}
+		}
+
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"data":   map[string]interface{}{field: nil},
+			"errors": []*graphError{gqlErr},
+		})
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"data": map[string]interface{}{field: data},
+	})
+}
+
+// execute runs an operation against the state and returns the name of the
+// root field of the response with its value.
+func (s *Server) execute(operation string, variables map[string]json.RawMessage) (string, interface{}, *graphError) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	switch operation {
+	case "GetSettings":
+		var id string
+		if err := decodeVariable(variables, "id", &id); err != nil {
+			return "getSettings", nil, err
+		}
+
+		if s.settings == nil || id != settingsId {
+			return "getSettings", nil, nil
+		}
+
+		return "getSettings", s.settings, nil
+
+	case "CreateSettings":
+		var in awsteam.Settings
+		if err := decodeVariable(variables, "input", &in); err != nil {
+			return "createSettings", nil, err
+		}
+
+		if s.settings != nil {
+			return "createSettings", nil, conditionalCheckFailed()
+		}
+
+		in.CreatedAt, in.UpdatedAt = now(), now()
+		s.settings = &in
+
+		return "createSettings", s.settings, nil
+
+	case "UpdateSettings":
+		var in awsteam.Settings
+		if err := decodeVariable(variables, "input", &in); err != nil {
+			return "updateSettings", nil, err
+		}
+
+		if s.settings == nil {
+			return "updateSettings", nil, conditionalCheckFailed()
+		}
+
+		in.CreatedAt, in.UpdatedAt = s.settings.CreatedAt, now()
+		s.settings = &in
+
+		return "updateSettings", s.settings, nil
+
+	case "DeleteSettings":
+		if s.settings == nil {
+			return "deleteSettings", nil, conditionalCheckFailed()
+		}
+
+		deleted := s.settings
+		s.settings = nil
+
+		return "deleteSettings", deleted, nil
+
+	case "GetEligibility":
+		var id string
+		if err := decodeVariable(variables, "id", &id); err != nil {
+			return "getEligibility", nil, err
+		}
+
+		return "getEligibility", s.eligibilities[id], nil
+
+	case "CreateEligibility":
+		var in awsteam.Eligibility
+		if err := decodeVariable(variables, "input", &in); err != nil {
+			return "createEligibility", nil, err
+		}
+
+		if _, ok := s.eligibilities[ptr.ToString(in.Id)]; ok {
+			return "createEligibility", nil, conditionalCheckFailed()
+		}
+
+		in.CreatedAt, in.UpdatedAt = now(), now()
+		s.eligibilities[ptr.ToString(in.Id)] = &in
+
+		return "createEligibility", &in, nil
+
+	case "UpdateEligibility":
+		var in awsteam.Eligibility
+		if err := decodeVariable(variables, "input", &in); err != nil {
+			return "updateEligibility", nil, err
+		}
+
+		current, ok := s.eligibilities[ptr.ToString(in.Id)]
+
+		if !ok {
+			return "updateEligibility", nil, conditionalCheckFailed()
+		}
+
+		in.CreatedAt, in.UpdatedAt = current.CreatedAt, now()
+		s.eligibilities[ptr.ToString(in.Id)] = &in
+
+		return "updateEligibility", &in, nil
+
+	case "DeleteEligibility":
+		var in struct {
+			Id string `json:"id"`
+		}
+		if err := decodeVariable(variables, "input", &in); err != nil {
+			return "deleteEligibility", nil, err
+		}
+
+		deleted, ok := s.eligibilities[in.Id]
+
+		if !ok {
+			return "deleteEligibility", nil, conditionalCheckFailed()
+		}
+
+		delete(s.eligibilities, in.Id)
+
+		return "deleteEligibility", deleted, nil
+
+	case "ListEligibilities":
+		items := make([]*awsteam.Eligibility, 0, len(s.eligibilities))
+
+		for _, eligibility := range s.eligibilities {
+			items = append(items, eligibility)
+		}
+
+		sort.Slice(items, func(i, j int) bool { return ptr.ToString(items[i].Id) < ptr.ToString(items[j].Id) })
+
+		page, nextToken, err := paginate(variables, len(items))
+		if err != nil {
+			return "listEligibilities", nil, err
+		}
+
+		return "listEligibilities", map[string]interface{}{"items": items[page[0]:page[1]], "nextToken": nextToken}, nil
+
+	case "GetApprovers":
+		var id string
+		if err := decodeVariable(variables, "id", &id); err != nil {
+			return "getApprovers", nil, err
+		}
+
+		return "getApprovers", s.approvers[id], nil
+
+	case "CreateApprovers":
+		var in awsteam.Approvers
+		if err := decodeVariable(variables, "input", &in); err != nil {
+			return "createApprovers", nil, err
+		}
+
+		if _, ok := s.approvers[ptr.ToString(in.Id)]; ok {
+			return "createApprovers", nil, conditionalCheckFailed()
+		}
+
+		in.CreatedAt, in.UpdatedAt = now(), now()
+		s.approvers[ptr.ToString(in.Id)] = &in
+
+		return "createApprovers", &in, nil
+
+	case "UpdateApprovers":
+		var in awsteam.Approvers
+		if err := decodeVariable(variables, "input", &in); err != nil {
+			return "updateApprovers", nil, err
+		}
+
+		current, ok := s.approvers[ptr.ToString(in.Id)]
+
+		if !ok {
+			return "updateApprovers", nil, conditionalCheckFailed()
+		}
+
+		in.CreatedAt, in.UpdatedAt = current.CreatedAt, now()
+		s.approvers[ptr.ToString(in.Id)] = &in
+
+		return "updateApprovers", &in, nil
+
+	case "DeleteApprovers":
+		var in struct {
+			Id string `json:"id"`
+		}
+		if err := decodeVariable(variables, "input", &in); err != nil {
+			return "deleteApprovers", nil, err
+		}
+
+		deleted, ok := s.approvers[in.Id]
+
+		if !ok {
+			return "deleteApprovers", nil, conditionalCheckFailed()
+		}
+
+		delete(s.approvers, in.Id)
+
+		return "deleteApprovers", deleted, nil
+
+	case "ListApprovers":
+		items := make([]*awsteam.Approvers, 0, len(s.approvers))
+
+		for _, approvers := range s.approvers {
+			items = append(items, approvers)
+		}
+
+		sort.Slice(items, func(i, j int) bool { return ptr.ToString(items[i].Id) < ptr.ToString(items[j].Id) })
+
+		page, nextToken, err := paginate(variables, len(items))
+		if err != nil {
+			return "listApprovers", nil, err
+		}
+
+		return "listApprovers", map[string]interface{}{"items": items[page[0]:page[1]], "nextToken": nextToken}, nil
+
+	case "GetAccounts":
+		return "getAccounts", nonNil(s.accounts), nil
+
+	case "GetOU":
+		var id string
+		if err := decodeVariable(variables, "id", &id); err != nil {
+			return "getOU", nil, err
+		}
+
+		parent, ok := s.parents[id]
+
+		if !ok {
+			return "getOU", nil, nil
+		}
+
+		return "getOU", map[string]string{"Id": parent}, nil
+
+	case "GetOUs":
+		if s.root == nil {
+			return "getOUs", nil, nil
+		}
+
+		ous, err := json.Marshal(s.root)
+
+		if err != nil {
+			panic(err)
+		}
+
+		return "getOUs", map[string]string{"ous": string(ous)}, nil
+
+	case "GetIdCUsers":
+		return "getUsers", nonNil(s.users), nil
+
+	case "GetIdCGroups":
+		return "getIdCGroups", nonNil(s.groups), nil
+	}
+
+	return "", nil, &graphError{
+		ErrorType: "BadRequestException",
+		Message:   fmt.Sprintf("awsteamtest: operation %q is not implemented", operation),
+	}
+}
+
+// decodeVariable decodes the variable with the given name into v.
+func decodeVariable(variables map[string]json.RawMessage, name string, v interface{}) *graphError {
+	raw, ok := variables[name]
+
+	if !ok || string(raw) == "null" {
+		return &graphError{ErrorType: "ValidationError", Message: fmt.Sprintf("Variable '%s' has an invalid value: null", name)}
+	}
+
+	if err := json.Unmarshal(raw, v); err != nil {
+		return &graphError{ErrorType: "ValidationError", Message: fmt.Sprintf("Variable '%s' has an invalid value: %s", name, err)}
+	}
+
+	return nil
+}
+
+// paginate returns the bounds of the page of n items selected by the limit
+// and nextToken variables, and the token of the next page.
+func paginate(variables map[string]json.RawMessage, n int) ([2]int, *string, *graphError) {
+	var limit *int
+	var nextToken *string
+
+	if raw, ok := variables["limit"]; ok {
+		_ = json.Unmarshal(raw, &limit)
+	}
+
+	if raw, ok := variables["nextToken"]; ok {
+		_ = json.Unmarshal(raw, &nextToken)
+	}
+
+	start, end := 0, n
+
+	if nextToken != nil {
+		var err error
+
+		start, err = strconv.Atoi(*nextToken)
+
+		if err != nil || start < 0 || start > n {
+			return [2]int{}, nil, &graphError{ErrorType: "DynamoDB:DynamoDbException", Message: "Invalid pagination token given."}
+		}
+	}
+
+	size := defaultLimit
+
+	if limit != nil && *limit > 0 {
+		size = *limit
+	}
+
+	if start+size < n {
+		end = start + size
+		return [2]int{start, end}, ptr.String(strconv.Itoa(end)), nil
+	}
+
+	return [2]int{start, end}, nil, nil
+}
+
+func conditionalCheckFailed() *graphError {
+	return &graphError{
+		ErrorType: "DynamoDB:ConditionalCheckFailedException",
+		Message:   "The conditional request failed (Service: DynamoDb, Status Code: 400)",
+	}
+}
+
+func now() *string {
+	return ptr.String(time.Now().UTC().Format("2006-01-02T15:04:05.000Z"))
+}
+
+// nonNil returns items, or an empty slice when items is nil so it is encoded
+// as an empty list.
+func nonNil[T any](items []T) []T {
+	if items == nil {
+		return []T{}
+	}
+
+	return items
+}
+
+// clone returns a deep copy of v.
+func clone[T any](v *T) *T {
+	if v == nil {
+		return nil
+	}
+
+	b, err := json.Marshal(v)
+
+	if err != nil {
+		panic(err)
+	}
+
+	c := new(T)
+
+	if err := json.Unmarshal(b, c); err != nil {
+		panic(err)
+	}
+
+	return c
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,323 @@
+package awsteamtest
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aws/smithy-go/ptr"
+	"github.com/awsteam-contrib/terraform-provider-awsteam/internal/sdk/awsteam"
+)
+
+func newTestClient(t *testing.T, s *Server) *awsteam.Client {
+	t.Helper()
+
+	ctx := context.Background()
+	config := s.Config()
+
+	if err := config.Build(ctx); err != nil {
+		t.Fatalf("building config: %s", err)
+	}
+
+	client, err := config.NewClient(ctx)
+
+	if err != nil {
+		t.Fatalf("creating client: %s", err)
+	}
+
+	return client
+}
+
+func TestServer_token(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+
+	config := s.Config()
+	config.ClientSecret = "wrong"
+
+	err := config.Build(context.Background())
+
+	var tokenErr *awsteam.TokenError
+
+	if !errors.As(err, &tokenErr) || tokenErr.Code != "invalid_client" {
+		t.Fatalf("expected an invalid_client token error, got %v", err)
+	}
+}
+
+func TestServer_settings(t *testing.T) {
+	ctx := context.Background()
+	s := NewServer()
+	defer s.Close()
+
+	client := newTestClient(t, s)
+
+	if _, err := client.GetSettings(ctx, &awsteam.GetSettingsInput{}); !isNotFound(err) {
+		t.Fatalf("expected not found before create, got %v", err)
+	}
+
+	_, err := client.CreateSettings(ctx, &awsteam.CreateSettingsInput{Duration: ptr.Int64(9), Approval: ptr.Bool(true)})
+
+	if err != nil {
+		t.Fatalf("creating settings: %s", err)
+	}
+
+	if _, err := client.CreateSettings(ctx, &awsteam.CreateSettingsInput{}); !isConflict(err) {
+		t.Errorf("expected a conflict creating settings twice, got %v", err)
+	}
+
+	_, err = client.UpdateSettings(ctx, &awsteam.UpdateSettingsInput{Duration: ptr.Int64(4), Approval: ptr.Bool(false)})
+
+	if err != nil {
+		t.Fatalf("updating settings: %s", err)
+	}
+
+	out, err := client.GetSettings(ctx, &awsteam.GetSettingsInput{})
+
+	if err != nil {
+		t.Fatalf("reading settings: %s", err)
+	}
+
+	if ptr.ToInt64(out.Settings.Duration) != 4 || ptr.ToBool(out.Settings.Approval) {
+		t.Errorf("got duration %d and approval %t, want 4 and false", ptr.ToInt64(out.Settings.Duration), ptr.ToBool(out.Settings.Approval))
+	}
+
+	if out.Settings.CreatedAt == nil || out.Settings.UpdatedAt == nil {
+		t.Error("expected timestamps to be set")
+	}
+
+	if _, err := client.DeleteSettings(ctx, &awsteam.DeleteSettingsInput{}); err != nil {
+		t.Fatalf("deleting settings: %s", err)
+	}
+
+	if s.Settings() != nil {
+		t.Error("settings still stored after delete")
+	}
+
+	if _, err := client.DeleteSettings(ctx, &awsteam.DeleteSettingsInput{}); !isConflict(err) {
+		t.Errorf("expected a conflict deleting settings twice, got %v", err)
+	}
+}
+
+func TestServer_eligibility(t *testing.T) {
+	ctx := context.Background()
+	s := NewServer()
+	defer s.Close()
+
+	client := newTestClient(t, s)
+
+	for _, id := range []string{"c", "a", "b"} {
+		_, err := client.CreateEligibility(ctx, &awsteam.CreateEligibilityInput{
+			Id:          ptr.String(id),
+			Name:        ptr.String("name-" + id),
+			Type:        ptr.String("User"),
+			Duration:    ptr.Int64(2),
+			Permissions: []*awsteam.EligibilityPermission{{Id: ptr.String("arn"), Name: ptr.String("Admin")}},
+		})
+
+		if err != nil {
+			t.Fatalf("creating eligibility %s: %s", id, err)
+		}
+	}
+
+	if _, err := client.UpdateEligibility(ctx, &awsteam.UpdateEligibilityInput{Id: ptr.String("missing")}); !isConflict(err) {
+		t.Errorf("expected a conflict updating a missing eligibility, got %v", err)
+	}
+
+	_, err := client.UpdateEligibility(ctx, &awsteam.UpdateEligibilityInput{Id: ptr.String("a"), Name: ptr.String("renamed"), Duration: ptr.Int64(5)})
+
+	if err != nil {
+		t.Fatalf("updating eligibility: %s", err)
+	}
+
+	out, err := client.GetEligibility(ctx, &awsteam.GetEligibilityInput{Id: ptr.String("a")})
+
+	if err != nil {
+		t.Fatalf("reading eligibility: %s", err)
+	}
+
+	if ptr.ToString(out.Eligibility.Name) != "renamed" || ptr.ToInt64(out.Eligibility.Duration) != 5 {
+		t.Errorf("got %q and %d, want the updated name and duration", ptr.ToString(out.Eligibility.Name), ptr.ToInt64(out.Eligibility.Duration))
+	}
+
+	var ids []string
+
+	paginator := awsteam.NewListEligibilitiesPaginator(client, &awsteam.ListEligibilitiesInput{Limit: ptr.Int32(2)})
+
+	for pages := 0; paginator.HasMorePages(); pages++ {
+		page, err := paginator.NextPage(ctx)
+
+		if err != nil {
+			t.Fatalf("listing eligibilities: %s", err)
+		}
+
+		if pages == 0 && len(page.Eligibilities) != 2 {
+			t.Errorf("got %d items on the first page, want 2", len(page.Eligibilities))
+		}
+
+		for _, e := range page.Eligibilities {
+			ids = append(ids, ptr.ToString(e.Id))
+		}
+	}
+
+	if len(ids) != 3 || ids[0] != "a" || ids[1] != "b" || ids[2] != "c" {
+		t.Errorf("got ids %v, want [a b c]", ids)
+	}
+
+	if _, err := client.DeleteEligibility(ctx, &awsteam.DeleteEligibilityInput{Id: ptr.String("b")}); err != nil {
+		t.Fatalf("deleting eligibility: %s", err)
+	}
+
+	if _, err := client.GetEligibility(ctx, &awsteam.GetEligibilityInput{Id: ptr.String("b")}); !isNotFound(err) {
+		t.Errorf("expected not found after delete, got %v", err)
+	}
+}
+
+func TestServer_approvers(t *testing.T) {
+	ctx := context.Background()
+	s := NewServer()
+	defer s.Close()
+
+	client := newTestClient(t, s)
+
+	_, err := client.CreateApprovers(ctx, &awsteam.CreateApproversInput{
+		Id:        ptr.String("123456789012"),
+		Name:      ptr.String("production"),
+		Type:      ptr.String("Account"),
+		Approvers: []*string{ptr.String("approvers")},
+		GroupIds:  []*string{ptr.String("group-1")},
+	})
+
+	if err != nil {
+		t.Fatalf("creating approvers: %s", err)
+	}
+
+	_, err = client.UpdateApprovers(ctx, &awsteam.UpdateApproversInput{
+		Id:       ptr.String("123456789012"),
+		Name:     ptr.String("production"),
+		Type:     ptr.String("Account"),
+		GroupIds: []*string{ptr.String("group-2")},
+	})
+
+	if err != nil {
+		t.Fatalf("updating approvers: %s", err)
+	}
+
+	stored := s.Approvers("123456789012")
+
+	if stored == nil || len(stored.GroupIds) != 1 || ptr.ToString(stored.GroupIds[0]) != "group-2" {
+		t.Errorf("got stored approvers %+v, want the updated group ids", stored)
+	}
+
+	list, err := client.ListApprovers(ctx, &awsteam.ListApproversInput{})
+
+	if err != nil {
+		t.Fatalf("listing approvers: %s", err)
+	}
+
+	if len(list.Approvers) != 1 || list.NextToken != nil {
+		t.Errorf("got %d approvers and next token %v, want 1 and none", len(list.Approvers), list.NextToken)
+	}
+
+	if _, err := client.DeleteApprovers(ctx, &awsteam.DeleteApproversInput{Id: ptr.String("123456789012")}); err != nil {
+		t.Fatalf("deleting approvers: %s", err)
+	}
+
+	if _, err := client.GetApprovers(ctx, &awsteam.GetApproversInput{Id: ptr.String("123456789012")}); !isNotFound(err) {
+		t.Errorf("expected not found after delete, got %v", err)
+	}
+}
+
+func TestServer_lookups(t *testing.T) {
+	ctx := context.Background()
+	s := NewServer()
+	defer s.Close()
+
+	client := newTestClient(t, s)
+
+	accounts, err := client.GetAccounts(ctx, &awsteam.GetAccountsInput{})
+
+	if err != nil || len(accounts.Accounts) != 0 {
+		t.Fatalf("got %v, %v, want no accounts", accounts, err)
+	}
+
+	s.AddAccounts(&awsteam.Account{Id: ptr.String("123456789012"), Name: ptr.String("production")})
+	s.AddUsers(&awsteam.IdCUser{UserId: ptr.String("user-1"), UserName: ptr.String("jane")})
+	s.AddGroups(&awsteam.IdCGroup{GroupId: ptr.String("group-1"), DisplayName: ptr.String("admins")})
+
+	accounts, err = client.GetAccounts(ctx, &awsteam.GetAccountsInput{})
+
+	if err != nil || len(accounts.Accounts) != 1 || ptr.ToString(accounts.Accounts[0].Name) != "production" {
+		t.Errorf("got %v, %v, want the added account", accounts, err)
+	}
+
+	users, err := client.GetIdCUsers(ctx, &awsteam.GetIdCUsersInput{})
+
+	if err != nil || len(users.Users) != 1 || ptr.ToString(users.Users[0].UserName) != "jane" {
+		t.Errorf("got %v, %v, want the added user", users, err)
+	}
+
+	groups, err := client.GetIdCGroups(ctx, &awsteam.GetIdCGroupsInput{})
+
+	if err != nil || len(groups.Groups) != 1 || ptr.ToString(groups.Groups[0].DisplayName) != "admins" {
+		t.Errorf("got %v, %v, want the added group", groups, err)
+	}
+}
+
+func TestServer_ous(t *testing.T) {
+	ctx := context.Background()
+	s := NewServer()
+	defer s.Close()
+
+	client := newTestClient(t, s)
+
+	if _, err := client.GetOU(ctx, &awsteam.GetOUInput{Id: ptr.String("123456789012")}); !isNotFound(err) {
+		t.Fatalf("expected not found for an account without parent, got %v", err)
+	}
+
+	s.SetOUs(&awsteam.OU{
+		Id: ptr.String("r-root"),
+		Children: []awsteam.OU{
+			{Id: ptr.String("ou-root-workloads"), Name: ptr.String("Workloads")},
+		},
+	})
+	s.SetParent("123456789012", "ou-root-workloads")
+
+	parent, err := client.GetOU(ctx, &awsteam.GetOUInput{Id: ptr.String("123456789012")})
+
+	if err != nil || ptr.ToString(parent.OU.Id) != "ou-root-workloads" {
+		t.Errorf("got %v, %v, want the parent of the account", parent, err)
+	}
+
+	ous, err := client.GetOUs(ctx, &awsteam.GetOUsInput{})
+
+	if err != nil || ous.Root.FindById("ou-root-workloads") == nil {
+		t.Errorf("got %v, %v, want the organization tree", ous, err)
+	}
+}
+
+func TestServer_unsupportedOperation(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+
+	client := newTestClient(t, s)
+
+	_, err := client.GetPermissions(context.Background(), &awsteam.GetPermissionsInput{})
+
+	var validation *awsteam.ValidationError
+
+	if !errors.As(err, &validation) {
+		t.Fatalf("expected a validation error, got %v", err)
+	}
+}
+
+func isNotFound(err error) bool {
+	var notFound *awsteam.NotFoundError
+
+	return errors.As(err, &notFound)
+}
+
+func isConflict(err error) bool {
+	var conflict *awsteam.ConflictError
+
+	return errors.As(err, &conflict)
+}
@@ -0,0 +1,857 @@
+package main
+
+import (
+	"fmt"
+	"go/format"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+const generatedHeader = "// Code generated by internal/generate/sdkops; DO NOT EDIT.\n"
+
+type generator struct {
+	schema     *ast.Schema
+	operations []*operation
+	structs    map[string][]goField
+}
+
+func newGenerator(schemaPath, operationsPath, typesPath string) (*generator, error) {
+	b, err := os.ReadFile(schemaPath)
+
+	if err != nil {
+		return nil, fmt.Errorf("reading schema: %w", err)
+	}
+
+	schema, err := gqlparser.LoadSchema(&ast.Source{Name: schemaPath, Input: string(b)})
+
+	if err != nil {
+		return nil, fmt.Errorf("loading schema: %w", err)
+	}
+
+	operations, err := loadOperations(operationsPath)
+
+	if err != nil {
+		return nil, err
+	}
+
+	structs, err := loadStructs(typesPath)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &generator{schema: schema, operations: operations, structs: structs}, nil
+}
+
+// generate returns the formatted source of each operation by file name.
+func (g *generator) generate() (map[string][]byte, error) {
+	files := map[string][]byte{}
+
+	for _, op := range g.operations {
+		src, err := g.operationFile(op)
+
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op.Name, err)
+		}
+
+		files["api_op_"+op.Name+".go"] = src
+	}
+
+	return files, nil
+}
+
+// resolved is an operation with the schema and Go types it refers to.
+type resolved struct {
+	*operation
+
+	kind        string // "query" or "mutation"
+	def         *ast.FieldDefinition
+	inputObject *ast.Definition // the type of the input argument, if any
+	fields      []resolvedField
+
+	returned   *ast.Definition // the type of the returned object
+	connection bool            // the returned object has items and nextToken
+	pathField  *ast.FieldDefinition
+	valueType  string // the Go type of the output field
+}
+
+type resolvedField struct {
+	inputField
+	gqlType *ast.Type
+}
+
+func (g *generator) resolve(op *operation) (*resolved, error) {
+	r := &resolved{operation: op}
+
+	if def := g.schema.Query.Fields.ForName(op.Field); def != nil {
+		r.kind, r.def = "query", def
+	} else if def := g.schema.Mutation.Fields.ForName(op.Field); def != nil {
+		r.kind, r.def = "mutation", def
+	} else {
+		return nil, fmt.Errorf("%s is not a field of Query or Mutation", op.Field)
+	}
+
+	if arg := r.def.Arguments.ForName("input"); arg != nil && len(r.def.Arguments) == 1 {
+		r.inputObject = g.schema.Types[arg.Type.Name()]
+	}
+
+	if err := r.resolveInput(); err != nil {
+		return nil, err
+	}
+
+	if err := g.resolveOutput(r); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+func (r *resolved) resolveInput() error {
+	fields := r.Input.Fields
+
+	if len(fields) == 0 {
+		if r.inputObject != nil {
+			for _, f := range r.inputObject.Fields {
+				fields = append(fields, inputField{Name: f.Name})
+			}
+		} else {
+			for _, arg := range r.def.Arguments {
+				fields = append(fields, inputField{Name: arg.Name})
+			}
+		}
+	}
+
+	for _, f := range fields {
+		if f.Omit {
+			if r.inputObject == nil || f.GoName == "" || f.Type == "" {
+				return fmt.Errorf("omitted fields are only supported in input objects and need a Go name and type")
+			}
+
+			r.fields = append(r.fields, resolvedField{inputField: f})
+			continue
+		}
+
+		var gqlType *ast.Type
+
+		if r.inputObject != nil {
+			def := r.inputObject.Fields.ForName(f.Name)
+
+			if def == nil {
+				return fmt.Errorf("%s is not a field of %s", f.Name, r.inputObject.Name)
+			}
+
+			gqlType = def.Type
+		} else {
+			arg := r.def.Arguments.ForName(f.Name)
+
+			if arg == nil {
+				return fmt.Errorf("%s is not an argument of %s", f.Name, r.Field)
+			}
+
+			gqlType = arg.Type
+		}
+
+		if f.GoName == "" {
+			f.GoName = goName(f.Name)
+		}
+
+		if f.Type == "" {
+			t, err := goType(gqlType)
+
+			if err != nil {
+				return fmt.Errorf("%s: %w", f.Name, err)
+			}
+
+			f.Type = t
+		}
+
+		if f.Default != "" && f.Type != "*string" {
+			return fmt.Errorf("%s: defaults are only supported for strings", f.Name)
+		}
+
+		if f.EmptyList && (r.inputObject == nil || !strings.HasPrefix(f.Type, "[]")) {
+			return fmt.Errorf("%s: empty lists are only supported for lists in input objects", f.Name)
+		}
+
+		r.fields = append(r.fields, resolvedField{inputField: f, gqlType: gqlType})
+	}
+
+	return nil
+}
+
+func (g *generator) resolveOutput(r *resolved) error {
+	out := r.Output
+	returned := g.schema.Types[r.def.Type.Name()]
+
+	if returned == nil || returned.Kind != ast.Object {
+		return fmt.Errorf("%s does not return an object", r.Field)
+	}
+
+	if _, ok := g.structs[out.Type]; !ok {
+		return fmt.Errorf("%s is not a struct in types.go", out.Type)
+	}
+
+	r.returned = returned
+
+	switch {
+	case out.Path != "":
+		r.pathField = returned.Fields.ForName(out.Path)
+
+		if r.pathField == nil {
+			return fmt.Errorf("%s is not a field of %s", out.Path, returned.Name)
+		}
+
+		if out.Decode != "" {
+			if r.pathField.Type.Elem != nil || g.schema.Types[r.pathField.Type.Name()].Kind != ast.Scalar {
+				return fmt.Errorf("only scalar fields can be decoded")
+			}
+
+			if r.NotFound == nil {
+				return fmt.Errorf("decoded outputs have to be not found when missing")
+			}
+
+			r.valueType = "*" + out.Type
+		} else {
+			r.valueType = valueType(r.pathField.Type, out.Type)
+		}
+	case returned.Fields.ForName("items") != nil && returned.Fields.ForName("nextToken") != nil:
+		r.connection = true
+		r.valueType = valueType(returned.Fields.ForName("items").Type, out.Type)
+	default:
+		r.valueType = valueType(r.def.Type, out.Type)
+	}
+
+	if r.Paginate && !r.connection {
+		return fmt.Errorf("only operations returning a connection can be paginated")
+	}
+
+	return nil
+}
+
+func (g *generator) operationFile(op *operation) ([]byte, error) {
+	r, err := g.resolve(op)
+
+	if err != nil {
+		return nil, err
+	}
+
+	query, err := g.query(r)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// The schema test of the SDK checks the operations in detail; this only
+	// makes sure a broken operation is never written.
+	if _, errs := gqlparser.LoadQuery(g.schema, query); len(errs) > 0 {
+		return nil, fmt.Errorf("generated operation is invalid: %s\n%s", errs, query)
+	}
+
+	method, err := r.method(query)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var b strings.Builder
+
+	b.WriteString(generatedHeader)
+	b.WriteString("\npackage awsteam\n\n")
+	b.WriteString(r.imports())
+	b.WriteString("\n\n")
+	b.WriteString(r.inputStruct())
+	b.WriteString("\n\n")
+	b.WriteString(r.outputStruct())
+	b.WriteString("\n\n")
+	b.WriteString(method)
+
+	if r.Paginate {
+		b.WriteString("\n\n")
+		b.WriteString(r.paginator())
+	}
+
+	src, err := format.Source([]byte(b.String()))
+
+	if err != nil {
+		return nil, fmt.Errorf("formatting source: %w\n%s", err, b.String())
+	}
+
+	return src, nil
+}
+
+func (r *resolved) imports() string {
+	imports := []string{"context"}
+
+	if r.requiredCheck() != "" || r.Paginate {
+		imports = append(imports, "errors")
+	}
+
+	for _, f := range r.fields {
+		if f.Default != "" && r.inputObject != nil {
+			imports = append(imports, "github.com/aws/smithy-go/ptr")
+			break
+		}
+	}
+
+	var b strings.Builder
+
+	b.WriteString("import (\n")
+
+	for _, imp := range imports {
+		if strings.Contains(imp, ".") {
+			b.WriteString("\n")
+		}
+
+		fmt.Fprintf(&b, "\t%q\n", imp)
+	}
+
+	b.WriteString(")")
+
+	return b.String()
+}
+
+func (r *resolved) inputStruct() string {
+	var lines []string
+
+	for _, f := range r.fields {
+		tag := ""
+
+		if r.inputObject != nil {
+			options := ""
+
+			if f.String {
+				options += ",string"
+			}
+
+			if f.OmitEmpty {
+				options += ",omitempty"
+			}
+
+			tag = fmt.Sprintf(" `json:\"%s%s\"`", f.Name, options)
+
+			if f.Omit {
+				tag = " `json:\"-\"`"
+			}
+		}
+
+		lines = appendField(lines, f.Doc, fmt.Sprintf("%s %s%s", f.GoName, f.Type, tag))
+	}
+
+	return comment(r.Input.Doc, "") + structDecl(r.Name+"Input", lines)
+}
+
+func (r *resolved) outputStruct() string {
+	var lines []string
+
+	switch {
+	case r.connection:
+		lines = appendField(lines, r.Output.Doc, fmt.Sprintf("%s %s `json:\"items\"`", r.Output.Name, r.valueType))
+		lines = appendField(lines, "The token for the next page, nil when this is the last page.", "NextToken *string `json:\"nextToken\"`")
+	case r.pathField != nil:
+		lines = appendField(lines, r.Output.Doc, fmt.Sprintf("%s %s", r.Output.Name, r.valueType))
+	default:
+		lines = appendField(lines, r.Output.Doc, fmt.Sprintf("%s %s `json:\"%s\"`", r.Output.Name, r.valueType, r.Field))
+	}
+
+	return structDecl(r.Name+"Output", lines)
+}
+
+// appendField appends a struct field, separated from the previous field by a
+// blank line when it is documented.
+func appendField(lines []string, doc, field string) []string {
+	if doc != "" && len(lines) > 0 {
+		lines = append(lines, "")
+	}
+
+	return append(lines, comment(doc, "\t")+"\t"+field)
+}
+
+func structDecl(name string, lines []string) string {
+	if len(lines) == 0 {
+		return fmt.Sprintf("type %s struct{}", name)
+	}
+
+	return fmt.Sprintf("type %s struct {\n%s\n}", name, strings.Join(lines, "\n"))
+}
+
+// requiredCheck returns the condition under which required fields are missing.
+func (r *resolved) requiredCheck() string {
+	var conditions []string
+
+	for _, f := range r.fields {
+		if f.Required {
+			conditions = append(conditions, "in."+f.GoName+" == nil")
+		}
+	}
+
+	return strings.Join(conditions, " || ")
+}
+
+func (r *resolved) requiredMessage() string {
+	var names []string
+
+	for _, f := range r.fields {
+		if f.Required {
+			names = append(names, f.GoName)
+		}
+	}
+
+	verb, noun := splitName(r.Name)
+	subject := names[0] + " is"
+
+	if len(names) > 1 {
+		subject = strings.Join(names[:len(names)-1], ", ") + " and " + names[len(names)-1] + " are"
+	}
+
+	return fmt.Sprintf("%s required to %s %s.", subject, strings.ToLower(verb), noun)
+}
+
+// method returns the Client method of the operation. Its statements are
+// collected as blocks separated by blank lines.
+func (r *resolved) method(query string) (string, error) {
+	blocks := []string{fmt.Sprintf("if in == nil {\n\tin = &%sInput{}\n}", r.Name)}
+
+	if check := r.requiredCheck(); check != "" {
+		blocks = append(blocks, fmt.Sprintf("if %s {\n\treturn nil, errors.New(%q)\n}", check, r.requiredMessage()))
+	}
+
+	variablesArg := "nil"
+	values := map[string]string{}
+
+	if r.inputObject != nil {
+		blocks = append(blocks, "input := *in")
+
+		for _, f := range r.fields {
+			switch {
+			case f.Default != "":
+				blocks = append(blocks, fmt.Sprintf("if input.%s == nil {\n\tinput.%s = ptr.String(%s)\n}", f.GoName, f.GoName, f.Default))
+			case f.EmptyList:
+				blocks = append(blocks, fmt.Sprintf("if input.%s == nil {\n\tinput.%s = %s{}\n}", f.GoName, f.GoName, f.Type))
+			}
+
+			if f.Required || f.Default != "" {
+				values[f.Name] = "*input." + f.GoName
+			}
+		}
+
+		blocks = append(blocks, "variables := map[string]interface{}{\n\t\"input\": input,\n}")
+		variablesArg = "variables"
+	} else if len(r.fields) > 0 {
+		var entries []string
+
+		for _, f := range r.fields {
+			value := "in." + f.GoName
+
+			switch {
+			case f.Default != "":
+				blocks = append(blocks, fmt.Sprintf("%s := %s\n\nif in.%s != nil {\n\t%s = *in.%s\n}", f.Name, f.Default, f.GoName, f.Name, f.GoName))
+				value = f.Name
+			case f.Required:
+				value = "*in." + f.GoName
+			}
+
+			values[f.Name] = value
+			entries = append(entries, fmt.Sprintf("\t%q: %s,", f.Name, value))
+		}
+
+		blocks = append(blocks, fmt.Sprintf("variables := map[string]interface{}{\n%s\n}", strings.Join(entries, "\n")))
+		variablesArg = "variables"
+	}
+
+	blocks = append(blocks, "q := `"+query+"`")
+
+	meta := "_"
+	notFound := ""
+
+	if r.NotFound != nil {
+		meta = "meta"
+		id := strconv.Quote(r.NotFound.Literal)
+
+		if r.NotFound.Id != "" {
+			value, ok := values[r.NotFound.Id]
+
+			if !ok {
+				return "", fmt.Errorf("the not found id %s has to be a required input field or have a default", r.NotFound.Id)
+			}
+
+			id = value
+		}
+
+		notFound = fmt.Sprintf("return nil, newNotFoundError(%q, %q, %s, meta)", r.Name, r.Field, id)
+	}
+
+	invoke := func(out string) string {
+		return fmt.Sprintf("%s, err := client.invoke(ctx, %q, q, %s, %s)\n\nif err != nil {\n\treturn nil, err\n}", meta, r.Name, variablesArg, out)
+	}
+
+	switch {
+	case r.connection:
+		blocks = append(blocks,
+			fmt.Sprintf("var data struct {\n\tRoot *%sOutput `json:%q`\n}", r.Name, r.Field),
+			invoke("&data"),
+			fmt.Sprintf("if data.Root == nil {\n\treturn &%sOutput{}, nil\n}", r.Name),
+			"return data.Root, nil",
+		)
+	case r.pathField != nil:
+		valueType := r.valueType
+
+		if r.Output.Decode != "" {
+			valueType = "*string"
+		}
+
+		blocks = append(blocks,
+			fmt.Sprintf("var data struct {\n\tRoot *struct {\n\t\tValue %s `json:%q`\n\t} `json:%q`\n}", valueType, r.pathField.Name, r.Field),
+			invoke("&data"),
+		)
+
+		if r.NotFound != nil {
+			blocks = append(blocks, fmt.Sprintf("if data.Root == nil || data.Root.Value == nil {\n\t%s\n}", notFound))
+		}
+
+		blocks = append(blocks, fmt.Sprintf("out := &%sOutput{}", r.Name))
+
+		switch {
+		case r.Output.Decode != "":
+			blocks = append(blocks,
+				fmt.Sprintf("value, err := %s(*data.Root.Value)", r.Output.Decode),
+				fmt.Sprintf("if err != nil {\n\treturn nil, &UnexpectedResponseError{\n\t\tErrorDetails: ErrorDetails{\n\t\t\tOperation:  %q,\n\t\t\tRequestID:  meta.RequestID,\n\t\t\tStatusCode: meta.StatusCode,\n\t\t},\n\t\tErr: err,\n\t}\n}", r.Name),
+				fmt.Sprintf("out.%s = value", r.Output.Name),
+			)
+		case r.NotFound != nil:
+			blocks = append(blocks, fmt.Sprintf("out.%s = data.Root.Value", r.Output.Name))
+		default:
+			blocks = append(blocks, fmt.Sprintf("if data.Root != nil {\n\tout.%s = data.Root.Value\n}", r.Output.Name))
+		}
+
+		blocks = append(blocks, "return out, nil")
+	default:
+		blocks = append(blocks,
+			fmt.Sprintf("out := &%sOutput{}", r.Name),
+			invoke("out"),
+		)
+
+		if r.NotFound != nil {
+			conditions := []string{"out." + r.Output.Name + " == nil"}
+
+			for _, name := range r.NotFound.UnlessSet {
+				conditions = append(conditions, "out."+r.Output.Name+"."+name+" == nil")
+			}
+
+			blocks = append(blocks, fmt.Sprintf("if %s {\n\t%s\n}", strings.Join(conditions, " || "), notFound))
+		}
+
+		blocks = append(blocks, "return out, nil")
+	}
+
+	for i, block := range blocks {
+		blocks[i] = "\t" + strings.ReplaceAll(block, "\n", "\n\t")
+	}
+
+	body := strings.ReplaceAll(strings.Join(blocks, "\n\n"), "\n\t\n", "\n\n")
+
+	return fmt.Sprintf("%sfunc (client *Client) %s(ctx context.Context, in *%sInput) (*%sOutput, error) {\n%s\n}", comment(r.Doc, ""), r.Name, r.Name, r.Name, body), nil
+}
+
+// query returns the GraphQL document of the operation.
+func (g *generator) query(r *resolved) (string, error) {
+	var params, args []string
+
+	if r.inputObject != nil {
+		params = append(params, "$input: "+r.def.Arguments.ForName("input").Type.String())
+		args = append(args, "input: $input")
+	} else {
+		for _, f := range r.fields {
+			params = append(params, fmt.Sprintf("$%s: %s", f.Name, f.gqlType.String()))
+			args = append(args, fmt.Sprintf("%s: $%s", f.Name, f.Name))
+		}
+	}
+
+	header := fmt.Sprintf("%s %s", r.kind, r.Name)
+
+	if len(params) > 0 {
+		header += "(" + strings.Join(params, ", ") + ")"
+	}
+
+	call := r.Field
+
+	if len(args) > 0 {
+		call += "(" + strings.Join(args, ", ") + ")"
+	}
+
+	var lines []string
+
+	switch {
+	case r.connection:
+		items, err := g.selection(g.schema.Types[r.returned.Fields.ForName("items").Type.Name()], r.Output.Type, r.Select)
+
+		if err != nil {
+			return "", err
+		}
+
+		lines = append(lines, block("items", items)...)
+		lines = append(lines, "nextToken")
+	case r.pathField != nil && r.Output.Decode != "":
+		lines = append(lines, r.pathField.Name)
+	case r.pathField != nil:
+		selection, err := g.selection(g.schema.Types[r.pathField.Type.Name()], r.Output.Type, r.Select)
+
+		if err != nil {
+			return "", err
+		}
+
+		lines = block(r.pathField.Name, selection)
+	default:
+		selection, err := g.selection(r.returned, r.Output.Type, r.Select)
+
+		if err != nil {
+			return "", err
+		}
+
+		lines = selection
+	}
+
+	return strings.Join(block(header, block(call, lines)), "\n"), nil
+}
+
+// selection returns the fields of an object type that are decoded into a
+// types.go struct, in the order of the struct. Only the fields in only are
+// selected when it is not empty.
+func (g *generator) selection(def *ast.Definition, goStruct string, only []string) ([]string, error) {
+	if def == nil || def.Kind != ast.Object {
+		return nil, fmt.Errorf("%s is not decoded from an object", goStruct)
+	}
+
+	var lines []string
+
+	for _, name := range only {
+		if def.Fields.ForName(name) == nil {
+			return nil, fmt.Errorf("%s is not a field of %s", name, def.Name)
+		}
+	}
+
+	for _, field := range g.structs[goStruct] {
+		var schemaField *ast.FieldDefinition
+
+		for _, f := range def.Fields {
+			if strings.EqualFold(f.Name, field.JSON) {
+				schemaField = f
+				break
+			}
+		}
+
+		if schemaField == nil || (len(only) > 0 && !slices.Contains(only, schemaField.Name)) {
+			continue
+		}
+
+		fieldType := g.schema.Types[schemaField.Type.Name()]
+
+		if fieldType.Kind != ast.Object {
+			lines = append(lines, schemaField.Name)
+			continue
+		}
+
+		if field.Struct == "" {
+			return nil, fmt.Errorf("%s.%s does not hold a struct for the object %s", goStruct, field.Name, fieldType.Name)
+		}
+
+		selection, err := g.selection(fieldType, field.Struct, nil)
+
+		if err != nil {
+			return nil, err
+		}
+
+		lines = append(lines, block(schemaField.Name, selection)...)
+	}
+
+	if len(lines) == 0 {
+		return nil, fmt.Errorf("no field of %s is decoded into %s", def.Name, goStruct)
+	}
+
+	return lines, nil
+}
+
+func block(head string, lines []string) []string {
+	b := []string{head + " {"}
+
+	for _, line := range lines {
+		b = append(b, "\t"+line)
+	}
+
+	return append(b, "}")
+}
+
+func (r *resolved) paginator() string {
+	_, noun := splitName(r.Name)
+
+	// The doc comments are wrapped once the names are known, so they break at
+	// the same width as the other comments.
+	return strings.NewReplacer(
+		"{{paginatorDoc}}", comment(fmt.Sprintf("%sPaginator pages through all %s by following the NextToken of each page.", r.Name, strings.ToLower(noun)), ""),
+		"{{newPaginatorDoc}}", comment(fmt.Sprintf("New%sPaginator returns a paginator for %s. The NextToken of in, if any, is where the first page starts.", r.Name, r.Name), ""),
+		"{{Name}}", r.Name,
+	).Replace(paginatorTemplate)
+}
+
+const paginatorTemplate = `{{paginatorDoc}}type {{Name}}Paginator struct {
+	client    *Client
+	params    {{Name}}Input
+	nextToken *string
+	firstPage bool
+}
+
+{{newPaginatorDoc}}func New{{Name}}Paginator(client *Client, in *{{Name}}Input) *{{Name}}Paginator {
+	if in == nil {
+		in = &{{Name}}Input{}
+	}
+
+	return &{{Name}}Paginator{
+		client:    client,
+		params:    *in,
+		nextToken: in.NextToken,
+		firstPage: true,
+	}
+}
+
+// HasMorePages returns whether more pages are available.
+func (p *{{Name}}Paginator) HasMorePages() bool {
+	return p.firstPage || (p.nextToken != nil && *p.nextToken != "")
+}
+
+// NextPage retrieves the next {{Name}} page.
+func (p *{{Name}}Paginator) NextPage(ctx context.Context) (*{{Name}}Output, error) {
+	if !p.HasMorePages() {
+		return nil, errors.New("no more pages available")
+	}
+
+	params := p.params
+	params.NextToken = p.nextToken
+
+	out, err := p.client.{{Name}}(ctx, &params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	p.firstPage = false
+	prevToken := p.nextToken
+	p.nextToken = out.NextToken
+
+	if prevToken != nil && p.nextToken != nil && *prevToken == *p.nextToken {
+		p.nextToken = nil
+		return nil, errors.New("next page token did not change, stopping to avoid an endless loop")
+	}
+
+	return out, nil
+}`
+
+// goType returns the Go type of a scalar or list of scalars.
+func goType(t *ast.Type) (string, error) {
+	if t.Elem != nil {
+		elem, err := goType(t.Elem)
+
+		if err != nil {
+			return "", err
+		}
+
+		return "[]" + elem, nil
+	}
+
+	switch t.NamedType {
+	case "ID", "String", "AWSDateTime", "AWSJSON":
+		return "*string", nil
+	case "Boolean":
+		return "*bool", nil
+	case "Int":
+		return "*int32", nil
+	case "Float":
+		return "*float64", nil
+	default:
+		return "", fmt.Errorf("no Go type for %s, set one in the operation", t.NamedType)
+	}
+}
+
+// valueType returns the Go type holding a schema type decoded into goStruct.
+func valueType(t *ast.Type, goStruct string) string {
+	if t.Elem != nil {
+		return "[]*" + goStruct
+	}
+
+	return "*" + goStruct
+}
+
+// goName returns a field name in Go style, e.g. approver_ids as ApproverIds.
+func goName(name string) string {
+	var b strings.Builder
+
+	upper := true
+
+	for _, c := range name {
+		if c == '_' {
+			upper = true
+			continue
+		}
+
+		if upper {
+			c = unicode.ToUpper(c)
+			upper = false
+		}
+
+		b.WriteRune(c)
+	}
+
+	return b.String()
+}
+
+// splitName splits an operation name into its verb and noun, e.g. GetOU into
+// Get and OU.
+func splitName(name string) (string, string) {
+	for i, c := range name {
+		if i > 0 && unicode.IsUpper(c) {
+			return name[:i], name[i:]
+		}
+	}
+
+	return name, ""
+}
+
+// comment returns text as a doc comment wrapped at 80 columns.
+func comment(text, indent string) string {
+	if text == "" {
+		return ""
+	}
+
+	width := 77 - 4*len(indent)
+
+	var lines []string
+
+	line := ""
+
+	for _, word := range strings.Fields(text) {
+		if line != "" && len(line)+1+len(word) > width {
+			lines = append(lines, line)
+			line = ""
+		}
+
+		if line != "" {
+			line += " "
+		}
+
+		line += word
+	}
+
+	lines = append(lines, line)
+
+	var b strings.Builder
+
+	for _, line := range lines {
+		fmt.Fprintf(&b, "%s// %s\n", indent, line)
+	}
+
+	return b.String()
+}
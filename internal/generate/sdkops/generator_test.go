@@ -0,0 +1,87 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const sdkDir = "../../sdk/awsteam"
+
+// TestGenerate_upToDate fails when the operations of the SDK were edited by
+// hand or not regenerated after a change to their sources.
+func TestGenerate_upToDate(t *testing.T) {
+	g, err := newGenerator(filepath.Join(sdkDir, "schema.graphql"), filepath.Join(sdkDir, "operations.json"), filepath.Join(sdkDir, "types.go"))
+
+	if err != nil {
+		t.Fatalf("loading sources: %s", err)
+	}
+
+	files, err := g.generate()
+
+	if err != nil {
+		t.Fatalf("generating operations: %s", err)
+	}
+
+	for name, want := range files {
+		got, err := os.ReadFile(filepath.Join(sdkDir, name))
+
+		if err != nil {
+			t.Errorf("reading %s: %s", name, err)
+			continue
+		}
+
+		if string(got) != string(want) {
+			t.Errorf("%s is not up to date, run go generate ./internal/sdk/awsteam", name)
+		}
+	}
+}
+
+func TestGoName(t *testing.T) {
+	for name, want := range map[string]string{
+		"id":               "Id",
+		"approver_ids":     "ApproverIds",
+		"session_duration": "SessionDuration",
+		"nextToken":        "NextToken",
+	} {
+		if got := goName(name); got != want {
+			t.Errorf("goName(%q) = %q, want %q", name, got, want)
+		}
+	}
+}
+
+func TestRequiredMessage(t *testing.T) {
+	r := &resolved{operation: &operation{Name: "CreateRequest"}}
+	r.fields = []resolvedField{
+		{inputField: inputField{GoName: "AccountId", Required: true}},
+		{inputField: inputField{GoName: "AccountName"}},
+		{inputField: inputField{GoName: "RoleId", Required: true}},
+	}
+
+	if got, want := r.requiredMessage(), "AccountId and RoleId are required to create Request."; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestComment(t *testing.T) {
+	text := "ListEligibilitiesPaginator pages through all eligibilities by following the NextToken of each page."
+
+	if got, want := comment(text, ""), "// ListEligibilitiesPaginator pages through all eligibilities by following the\n// NextToken of each page.\n"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+
+	if got, want := comment(text, "\t"), "\t// ListEligibilitiesPaginator pages through all eligibilities by following\n\t// the NextToken of each page.\n"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestPaginator_commentWidth(t *testing.T) {
+	r := &resolved{operation: &operation{Name: "ListEligibilities"}}
+
+	for _, line := range strings.Split(r.paginator(), "\n") {
+		if strings.HasPrefix(line, "//") && len(line) > 80 {
+			t.Errorf("comment line is %d columns wide: %s", len(line), line)
+		}
+	}
+}
@@ -0,0 +1,80 @@
+// Command sdkops generates the operations of the awsteam SDK from the TEAM
+// GraphQL schema and a list of operations.
+//
+// Each operation is written to api_op_<Name>.go with its input and output
+// structs and a Client method that sends the operation with variables. The
+// fields selected from the objects TEAM returns are the fields of the types.go
+// structs they are decoded into that the schema type has.
+//
+// Run it with go generate in internal/sdk/awsteam.
+package main
+
+import (
+	"flag"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("sdkops: ")
+
+	schemaPath := flag.String("schema", "schema.graphql", "path of the TEAM GraphQL schema")
+	operationsPath := flag.String("operations", "operations.json", "path of the list of operations")
+	typesPath := flag.String("types", "types.go", "path of the Go file declaring the types TEAM objects are decoded into")
+	dir := flag.String("dir", ".", "directory the operations are written to")
+	flag.Parse()
+
+	g, err := newGenerator(*schemaPath, *operationsPath, *typesPath)
+
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	files, err := g.generate()
+
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := removeStale(*dir, files); err != nil {
+		log.Fatal(err)
+	}
+
+	for name, b := range files {
+		if err := os.WriteFile(filepath.Join(*dir, name), b, 0o644); err != nil {
+			log.Fatal(err)
+		}
+	}
+}
+
+// removeStale removes generated operations that are no longer in the list.
+func removeStale(dir string, files map[string][]byte) error {
+	paths, err := filepath.Glob(filepath.Join(dir, "api_op_*.go"))
+
+	if err != nil {
+		return err
+	}
+
+	for _, path := range paths {
+		if _, ok := files[filepath.Base(path)]; ok || strings.HasSuffix(path, "_test.go") {
+			continue
+		}
+
+		b, err := os.ReadFile(path)
+
+		if err != nil {
+			return err
+		}
+
+		if strings.HasPrefix(string(b), generatedHeader) {
+			if err := os.Remove(path); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,124 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// operation is an entry of the list of operations.
+type operation struct {
+	// Name is the name of the Client method and of the GraphQL operation.
+	Name string `json:"name"`
+
+	// Field is the field of the Query or Mutation type the operation sends.
+	Field string `json:"field"`
+
+	// Doc is the doc comment of the Client method.
+	Doc string `json:"doc"`
+
+	Input  input  `json:"input"`
+	Output output `json:"output"`
+
+	// Select limits the fields selected from the returned object. All the
+	// fields of the output type are selected when it is empty.
+	Select []string `json:"select"`
+
+	// NotFound makes the method return a NotFoundError when TEAM returns no
+	// object.
+	NotFound *notFound `json:"notFound"`
+
+	// Paginate generates a paginator for an operation returning a connection.
+	Paginate bool `json:"paginate"`
+}
+
+// input describes the input struct of an operation. Operations taking an
+// input object send the struct as the input variable; other operations send
+// each field as the variable of the argument of the same name.
+type input struct {
+	Doc string `json:"doc"`
+
+	// Fields are the arguments or input object fields in the struct, all of
+	// them when empty.
+	Fields []inputField `json:"fields"`
+}
+
+type inputField struct {
+	// Name is the name of the argument or input object field.
+	Name string `json:"name"`
+
+	// GoName is the name of the struct field, Name in Go style by default.
+	GoName string `json:"goName"`
+
+	// Type is the Go type of the struct field, derived from the schema type by
+	// default.
+	Type string `json:"type"`
+
+	// String encodes a number as a JSON string.
+	String bool `json:"string"`
+
+	// OmitEmpty leaves the field out of the input object when it is not set.
+	OmitEmpty bool `json:"omitEmpty"`
+
+	// Required makes the method fail when the field is not set.
+	Required bool `json:"required"`
+
+	// Default is the Go expression of the string sent when the field is not
+	// set.
+	Default string `json:"default"`
+
+	// Omit keeps a field in the struct that is never sent. GoName and Type
+	// have to be set.
+	Omit bool `json:"omit"`
+
+	// EmptyList sends an empty list when the field is not set.
+	EmptyList bool `json:"emptyList"`
+
+	Doc string `json:"doc"`
+}
+
+// output describes the field of the output struct holding the returned object.
+type output struct {
+	// Name is the name of the struct field.
+	Name string `json:"name"`
+
+	// Type is the types.go struct the object is decoded into.
+	Type string `json:"type"`
+
+	// Path is the field of the returned object holding the value, when the
+	// value is not the returned object itself.
+	Path string `json:"path"`
+
+	// Decode is the function decoding the scalar at Path into Type.
+	Decode string `json:"decode"`
+
+	Doc string `json:"doc"`
+}
+
+type notFound struct {
+	// Id is the input field identifying the missing object.
+	Id string `json:"id"`
+
+	// Literal identifies the missing object when the operation has no id.
+	Literal string `json:"literal"`
+
+	// UnlessSet are fields of the returned object that have to be set for it
+	// to be found.
+	UnlessSet []string `json:"unlessSet"`
+}
+
+func loadOperations(path string) ([]*operation, error) {
+	b, err := os.ReadFile(path)
+
+	if err != nil {
+		return nil, fmt.Errorf("reading operations: %w", err)
+	}
+
+	var operations []*operation
+
+	if err := json.Unmarshal(b, &operations); err != nil {
+		return nil, fmt.Errorf("decoding operations %s: %w", path, err)
+	}
+
+	return operations, nil
+}
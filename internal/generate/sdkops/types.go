@@ -0,0 +1,111 @@
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// goField is a JSON-decoded field of a struct declared in types.go.
+type goField struct {
+	Name string
+	JSON string
+
+	// Struct is the struct held by the field, if any.
+	Struct string
+}
+
+// loadStructs returns the JSON-decoded fields of the structs declared in a Go
+// file, in declaration order.
+func loadStructs(path string) (map[string][]goField, error) {
+	file, err := parser.ParseFile(token.NewFileSet(), path, nil, parser.SkipObjectResolution)
+
+	if err != nil {
+		return nil, fmt.Errorf("parsing types: %w", err)
+	}
+
+	structs := map[string][]goField{}
+
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+
+		if !ok || gen.Tok != token.TYPE {
+			continue
+		}
+
+		for _, spec := range gen.Specs {
+			typeSpec := spec.(*ast.TypeSpec)
+			structType, ok := typeSpec.Type.(*ast.StructType)
+
+			if !ok {
+				continue
+			}
+
+			var fields []goField
+
+			for _, field := range structType.Fields.List {
+				name := jsonName(field.Tag)
+
+				if name == "" {
+					continue
+				}
+
+				for _, ident := range field.Names {
+					fields = append(fields, goField{Name: ident.Name, JSON: name, Struct: elemIdent(field.Type)})
+				}
+			}
+
+			structs[typeSpec.Name.Name] = fields
+		}
+	}
+
+	for name, fields := range structs {
+		for i, field := range fields {
+			if _, ok := structs[field.Struct]; !ok {
+				structs[name][i].Struct = ""
+			}
+		}
+	}
+
+	return structs, nil
+}
+
+func jsonName(tag *ast.BasicLit) string {
+	if tag == nil {
+		return ""
+	}
+
+	value, err := strconv.Unquote(tag.Value)
+
+	if err != nil {
+		return ""
+	}
+
+	name, _, _ := strings.Cut(reflect.StructTag(value).Get("json"), ",")
+
+	if name == "-" {
+		return ""
+	}
+
+	return name
+}
+
+// elemIdent returns the name of the type held by pointers and slices.
+func elemIdent(expr ast.Expr) string {
+	for {
+		switch e := expr.(type) {
+		case *ast.StarExpr:
+			expr = e.X
+		case *ast.ArrayType:
+			expr = e.Elt
+		case *ast.Ident:
+			return e.Name
+		default:
+			return ""
+		}
+	}
+}
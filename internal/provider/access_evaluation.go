@@ -0,0 +1,181 @@
+package provider
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/aws/smithy-go/ptr"
+	"github.com/awsteam-contrib/terraform-provider-awsteam/internal/sdk/awsteam"
+)
+
+// accessRequest is an elevated access request to be evaluated.
+type accessRequest struct {
+	UserId        string
+	GroupIds      []string
+	AccountId     string
+	PermissionArn string
+
+	// The requested duration in hours, 0 when not set.
+	Duration int64
+}
+
+// accessData is the TEAM configuration an access request is evaluated
+// against.
+type accessData struct {
+	Settings      *awsteam.Settings
+	Eligibilities []*awsteam.Eligibility
+
+	// The OUs containing the requested account, nearest first.
+	Ancestors []*awsteam.OU
+
+	// The approvers policies keyed by account or OU id.
+	Approvers map[string]*awsteam.Approvers
+}
+
+// accessDecision is the outcome of evaluating an access request.
+type accessDecision struct {
+	Allowed          bool
+	ApprovalRequired bool
+
+	// The longest duration in hours that can be requested, 0 when unknown.
+	MaxDuration int64
+
+	// The ids of the eligibility policies granting the request.
+	EligibilityIds []string
+
+	// The approvers policies of the account and its OUs, only set when
+	// approval is required.
+	Approvers []*awsteam.Approvers
+
+	// Why the request is not allowed, empty when it is.
+	Reason string
+}
+
+// evaluateAccess decides an access request the way TEAM does:
+//
+//   - An eligibility policy applies when it belongs to the user or to one of
+//     the groups of the user.
+//   - A policy grants the request when it lists the account, or an OU
+//     containing it, and the permission set.
+//   - The maximum duration is the longest one of the granting policies,
+//     falling back to the default of the settings.
+//   - Approval is required unless it is disabled in the settings or a
+//     granting policy does not require it.
+//   - A request requiring approval is only allowed when the account or one of
+//     its OUs has approvers.
+func evaluateAccess(req accessRequest, data accessData) accessDecision {
+	var decision accessDecision
+
+	ouIds := []string{}
+
+	for _, ou := range data.Ancestors {
+		ouIds = append(ouIds, ptr.ToString(ou.Id))
+	}
+
+	approvalRequired := data.Settings == nil || data.Settings.Approval == nil || *data.Settings.Approval
+	eligibleForAccount := false
+	requiresApproval := true
+
+	for _, eligibility := range data.Eligibilities {
+		if eligibility == nil || !eligibilityAppliesTo(eligibility, req) {
+			continue
+		}
+
+		if !eligibilityIncludesAccount(eligibility, req.AccountId, ouIds) {
+			continue
+		}
+
+		eligibleForAccount = true
+
+		if !eligibilityIncludesPermission(eligibility, req.PermissionArn) {
+			continue
+		}
+
+		decision.EligibilityIds = append(decision.EligibilityIds, ptr.ToString(eligibility.Id))
+		decision.MaxDuration = max(decision.MaxDuration, ptr.ToInt64(eligibility.Duration))
+
+		if eligibility.ApprovalRequired != nil && !*eligibility.ApprovalRequired {
+			requiresApproval = false
+		}
+	}
+
+	if len(decision.EligibilityIds) == 0 {
+		if eligibleForAccount {
+			decision.Reason = fmt.Sprintf("No eligibility policy of the user or its groups grants the permission set %s on the account %s.", req.PermissionArn, req.AccountId)
+		} else {
+			decision.Reason = fmt.Sprintf("No eligibility policy of the user or its groups includes the account %s or one of its OUs.", req.AccountId)
+		}
+
+		return decision
+	}
+
+	if decision.MaxDuration == 0 && data.Settings != nil {
+		decision.MaxDuration = ptr.ToInt64(data.Settings.Duration)
+	}
+
+	decision.ApprovalRequired = approvalRequired && requiresApproval
+
+	if decision.ApprovalRequired {
+		decision.Approvers = accountApproverPolicies(req.AccountId, data.Ancestors, data.Approvers)
+	}
+
+	switch {
+	case req.Duration > 0 && decision.MaxDuration > 0 && req.Duration > decision.MaxDuration:
+		decision.Reason = fmt.Sprintf("The requested duration of %d hours exceeds the maximum of %d hours.", req.Duration, decision.MaxDuration)
+	case decision.ApprovalRequired && !hasApproverGroups(decision.Approvers):
+		decision.Reason = fmt.Sprintf("Approval is required, but neither the account %s nor its OUs have approvers.", req.AccountId)
+	default:
+		decision.Allowed = true
+	}
+
+	return decision
+}
+
+func eligibilityAppliesTo(eligibility *awsteam.Eligibility, req accessRequest) bool {
+	id := ptr.ToString(eligibility.Id)
+
+	switch ptr.ToString(eligibility.Type) {
+	case EligibilityUserType:
+		return id == req.UserId
+	case EligibilityGroupType:
+		return slices.Contains(req.GroupIds, id)
+	}
+
+	return false
+}
+
+func eligibilityIncludesAccount(eligibility *awsteam.Eligibility, accountId string, ouIds []string) bool {
+	for _, account := range eligibility.Accounts {
+		if account != nil && ptr.ToString(account.Id) == accountId {
+			return true
+		}
+	}
+
+	for _, ou := range eligibility.OUs {
+		if ou != nil && slices.Contains(ouIds, ptr.ToString(ou.Id)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func eligibilityIncludesPermission(eligibility *awsteam.Eligibility, permissionArn string) bool {
+	for _, permission := range eligibility.Permissions {
+		if permission != nil && ptr.ToString(permission.Id) == permissionArn {
+			return true
+		}
+	}
+
+	return false
+}
+
+func hasApproverGroups(policies []*awsteam.Approvers) bool {
+	for _, policy := range policies {
+		if len(policy.GroupIds) > 0 {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,277 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/YakDriver/regexache"
+	"github.com/awsteam-contrib/terraform-provider-awsteam/internal/sdk/awsteam"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ datasource.DataSource = &AccessEvaluationDataSource{}
+
+func NewAccessEvaluationDataSource() datasource.DataSource {
+	return &AccessEvaluationDataSource{}
+}
+
+type AccessEvaluationDataSource struct {
+	client *awsteam.Client
+}
+
+type AccessEvaluationModel struct {
+	Id               types.String `tfsdk:"id"`
+	UserId           types.String `tfsdk:"user_id"`
+	GroupIds         types.Set    `tfsdk:"group_ids"`
+	AccountId        types.String `tfsdk:"account_id"`
+	PermissionArn    types.String `tfsdk:"permission_arn"`
+	Duration         types.Int64  `tfsdk:"duration"`
+	Allowed          types.Bool   `tfsdk:"allowed"`
+	ApprovalRequired types.Bool   `tfsdk:"approval_required"`
+	MaxDuration      types.Int64  `tfsdk:"max_duration"`
+	EligibilityIds   types.List   `tfsdk:"eligibility_ids"`
+	Approvers        types.List   `tfsdk:"approvers"`
+	ApproverGroupIds types.Set    `tfsdk:"approver_group_ids"`
+	Reason           types.String `tfsdk:"reason"`
+}
+
+func (d *AccessEvaluationDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_access_evaluation"
+}
+
+func (d *AccessEvaluationDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Evaluates an elevated access request against the settings, eligibility policies and approvers of an AWS TEAM deployment without submitting it. " +
+			"Use it to review the effect of eligibility changes before they are applied.\n\n" +
+			"A request is granted by the eligibility policies of the user and of the groups in `group_ids` that list the account, or an OU containing it, together with the permission set. " +
+			"The maximum duration is the longest duration of these policies. " +
+			"Approval is required unless it is disabled in the settings or one of these policies does not require it. " +
+			"A request requiring approval is only allowed when the account or one of its OUs has approvers.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Access Evaluation Identifier. This is a static value of `access_evaluation`.",
+				Computed:            true,
+			},
+			"user_id": schema.StringAttribute{
+				MarkdownDescription: "The id of the IAM Identity Center user requesting access.",
+				Required:            true,
+			},
+			"group_ids": schema.SetAttribute{
+				MarkdownDescription: "The ids of the IAM Identity Center groups the user is a member of. The eligibility policies of these groups apply to the user.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"account_id": schema.StringAttribute{
+				MarkdownDescription: "The id of the AWS account access is requested for.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(
+						regexache.MustCompile(`^\d{12}$`),
+						"value must be a valid aws account id.",
+					),
+				},
+			},
+			"permission_arn": schema.StringAttribute{
+				MarkdownDescription: "The ARN of the requested permission set.",
+				Required:            true,
+			},
+			"duration": schema.Int64Attribute{
+				MarkdownDescription: "The requested duration in hours. When set, requests longer than `max_duration` are not allowed.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"allowed": schema.BoolAttribute{
+				MarkdownDescription: "Whether TEAM accepts the request.",
+				Computed:            true,
+			},
+			"approval_required": schema.BoolAttribute{
+				MarkdownDescription: "Whether the request has to be approved before access is granted.",
+				Computed:            true,
+			},
+			"max_duration": schema.Int64Attribute{
+				MarkdownDescription: "The longest duration in hours that can be requested. Not set when no eligibility policy grants the request.",
+				Computed:            true,
+			},
+			"eligibility_ids": schema.ListAttribute{
+				MarkdownDescription: "The ids of the users and groups whose eligibility policies grant the request.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"approvers": schema.ListNestedAttribute{
+				MarkdownDescription: "The approver groups with the policy each one comes from, only set when approval is required. The groups of the account policy are listed first, followed by those of the parent OU and its ancestors.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"group_id": schema.StringAttribute{
+							MarkdownDescription: "The id of the approver group.",
+							Computed:            true,
+						},
+						"group_name": schema.StringAttribute{
+							MarkdownDescription: "The name of the approver group.",
+							Computed:            true,
+						},
+						"source_id": schema.StringAttribute{
+							MarkdownDescription: "The account, OU or root id of the approvers policy the group comes from.",
+							Computed:            true,
+						},
+						"source_name": schema.StringAttribute{
+							MarkdownDescription: "The account or OU name of the approvers policy the group comes from.",
+							Computed:            true,
+						},
+						"source_type": schema.StringAttribute{
+							MarkdownDescription: "The type of the approvers policy the group comes from, either `Account` or `OU`.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"approver_group_ids": schema.SetAttribute{
+				MarkdownDescription: "The distinct ids of all approver groups, only set when approval is required.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"reason": schema.StringAttribute{
+				MarkdownDescription: "Why the request is not allowed. Empty when it is allowed.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *AccessEvaluationDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	meta, ok := req.ProviderData.(*AWSTEAMClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.AWSTEAMClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = meta.Client
+}
+
+func (d *AccessEvaluationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data AccessEvaluationModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request := accessRequest{
+		UserId:        data.UserId.ValueString(),
+		AccountId:     data.AccountId.ValueString(),
+		PermissionArn: data.PermissionArn.ValueString(),
+		Duration:      data.Duration.ValueInt64(),
+	}
+
+	if !data.GroupIds.IsNull() {
+		resp.Diagnostics.Append(data.GroupIds.ElementsAs(ctx, &request.GroupIds, false)...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	var teamData accessData
+
+	settings, err := d.client.GetSettings(ctx, &awsteam.GetSettingsInput{})
+
+	// Without settings TEAM applies its defaults, which require approval.
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.Append(clientErrorDiagnostic("Unable to read settings", err))
+		return
+	}
+
+	if err == nil {
+		teamData.Settings = settings.Settings
+	}
+
+	teamData.Eligibilities, err = listEligibilities(ctx, d.client)
+
+	if err != nil {
+		resp.Diagnostics.Append(clientErrorDiagnostic("Unable to read eligibilities", err))
+		return
+	}
+
+	ancestors, diags := readAccountAncestors(ctx, d.client, request.AccountId)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	teamData.Ancestors = ancestors
+
+	teamData.Approvers, err = listApprovers(ctx, d.client)
+
+	if err != nil {
+		resp.Diagnostics.Append(clientErrorDiagnostic("Unable to read approvers", err))
+		return
+	}
+
+	resp.Diagnostics.Append(data.flatten(evaluateAccess(request, teamData))...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "read access evaluation data source")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *AccessEvaluationModel) flatten(decision accessDecision) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	eligibilityIds := []attr.Value{}
+
+	for _, id := range decision.EligibilityIds {
+		eligibilityIds = append(eligibilityIds, types.StringValue(id))
+	}
+
+	list, listDiags := types.ListValue(types.StringType, eligibilityIds)
+	diags.Append(listDiags...)
+
+	// The approvers are flattened the same way as by the approvers data source.
+	approvers := ApproversModel{}
+	diags.Append(approvers.flatten(decision.Approvers)...)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	d.Id = types.StringValue("access_evaluation")
+	d.Allowed = types.BoolValue(decision.Allowed)
+	d.ApprovalRequired = types.BoolValue(decision.ApprovalRequired)
+	d.MaxDuration = types.Int64Null()
+	d.EligibilityIds = list
+	d.Approvers = approvers.Approvers
+	d.ApproverGroupIds = approvers.GroupIds
+	d.Reason = types.StringValue(decision.Reason)
+
+	if decision.MaxDuration > 0 {
+		d.MaxDuration = types.Int64Value(decision.MaxDuration)
+	}
+
+	return diags
+}
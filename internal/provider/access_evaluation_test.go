@@ -0,0 +1,293 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/smithy-go/ptr"
+	"github.com/awsteam-contrib/terraform-provider-awsteam/internal/sdk/awsteam"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const (
+	testAdminArn    = "arn:aws:sso:::permissionSet/ssoins-1111111111111111/ps-admin000000000"
+	testReadOnlyArn = "arn:aws:sso:::permissionSet/ssoins-1111111111111111/ps-readonly000000"
+)
+
+func TestAccAccessEvaluationDataSource_basic(t *testing.T) {
+	dataSourceName := "data.awsteam_access_evaluation.test"
+	user := gofakeit.Email()
+	userId := gofakeit.UUID()
+	permissionArn := "arn:aws:sso:::permissionSet/ssoins-4334d1f197f50907/ps-f5ge203d3d2428d3" // hard coded fake arn
+
+	// This environment variable should be set to the id of an account of the organization.
+	expectedAccountsIdVar := "AWSTEAM_TESTS_EXPECTED_ACCOUNT_ID"
+	expectedAccountsId := os.Getenv(expectedAccountsIdVar)
+	if expectedAccountsId == "" {
+		t.Skipf("Skipping Access Evaluation Tests, Environment variable %s is not set.", expectedAccountsIdVar)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccessEvaluationDataSourceConfig(user, userId, expectedAccountsId, permissionArn),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "id", "access_evaluation"),
+					resource.TestCheckResourceAttr(dataSourceName, "eligibility_ids.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "eligibility_ids.0", userId),
+					resource.TestCheckResourceAttr(dataSourceName, "max_duration", "3"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "allowed"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAccessEvaluationDataSourceConfig(user, userId, accountId, permissionArn string) string {
+	return fmt.Sprintf(`
+resource "awsteam_eligibility_user" "test" {
+  user_name         = %[1]q
+  user_id           = %[2]q
+  approval_required = true
+  duration          = 3
+  accounts = [
+    {
+      account_id = %[3]q
+    }
+  ]
+  permissions = [
+    {
+      permission_arn  = %[4]q
+      permission_name = "elevated"
+    }
+  ]
+}
+
+data "awsteam_access_evaluation" "test" {
+  user_id        = awsteam_eligibility_user.test.id
+  account_id     = %[3]q
+  permission_arn = %[4]q
+}`, user, userId, accountId, permissionArn)
+}
+
+// accessFixture is the TEAM configuration in testdata/access_evaluation.json.
+type accessFixture struct {
+	Settings      *awsteam.Settings      `json:"settings"`
+	OUs           *awsteam.OU            `json:"ous"`
+	Parents       map[string]string      `json:"parents"`
+	Eligibilities []*awsteam.Eligibility `json:"eligibilities"`
+	Approvers     []*awsteam.Approvers   `json:"approvers"`
+}
+
+func loadAccessFixture(t *testing.T) accessFixture {
+	t.Helper()
+
+	raw, err := os.ReadFile("testdata/access_evaluation.json")
+
+	if err != nil {
+		t.Fatalf("unable to read fixture: %s", err)
+	}
+
+	var fixture accessFixture
+
+	if err := json.Unmarshal(raw, &fixture); err != nil {
+		t.Fatalf("unable to decode fixture: %s", err)
+	}
+
+	return fixture
+}
+
+// data returns the configuration a request for the account is evaluated
+// against.
+func (f accessFixture) data(t *testing.T, accountId string) accessData {
+	t.Helper()
+
+	ancestors := ancestorsOf(f.OUs, f.Parents[accountId])
+
+	if ancestors == nil {
+		t.Fatalf("the account %s has no parent in the fixture", accountId)
+	}
+
+	approvers := map[string]*awsteam.Approvers{}
+
+	for _, policy := range f.Approvers {
+		approvers[*policy.Id] = policy
+	}
+
+	return accessData{
+		Settings:      f.Settings,
+		Eligibilities: f.Eligibilities,
+		Ancestors:     ancestors,
+		Approvers:     approvers,
+	}
+}
+
+func TestEvaluateAccess(t *testing.T) {
+	fixture := loadAccessFixture(t)
+
+	testCases := map[string]struct {
+		request  accessRequest
+		settings *awsteam.Settings
+
+		wantAllowed          bool
+		wantApprovalRequired bool
+		wantMaxDuration      int64
+		wantEligibilityIds   []string
+		wantApproverSources  []string
+		wantReason           bool
+	}{
+		"granted through an OU": {
+			request:              accessRequest{UserId: "user-john", GroupIds: []string{"group-platform"}, AccountId: "111111111111", PermissionArn: testAdminArn},
+			wantAllowed:          true,
+			wantApprovalRequired: true,
+			wantMaxDuration:      4,
+			wantEligibilityIds:   []string{"group-platform"},
+			wantApproverSources:  []string{"111111111111", "ou-abcd-workload"},
+		},
+		"policy without approval": {
+			request:            accessRequest{UserId: "user-john", GroupIds: []string{"group-platform", "group-sandbox"}, AccountId: "222222222222", PermissionArn: testAdminArn},
+			wantAllowed:        true,
+			wantMaxDuration:    8,
+			wantEligibilityIds: []string{"group-platform", "group-sandbox"},
+		},
+		"approval disabled in settings": {
+			request:            accessRequest{UserId: "user-john", GroupIds: []string{"group-platform"}, AccountId: "111111111111", PermissionArn: testAdminArn},
+			settings:           &awsteam.Settings{Approval: ptr.Bool(false), Duration: ptr.Int64(9)},
+			wantAllowed:        true,
+			wantMaxDuration:    4,
+			wantEligibilityIds: []string{"group-platform"},
+		},
+		"account not eligible": {
+			request:    accessRequest{UserId: "user-john", GroupIds: []string{"group-sandbox"}, AccountId: "111111111111", PermissionArn: testAdminArn},
+			wantReason: true,
+		},
+		"permission set not eligible": {
+			request:    accessRequest{UserId: "user-john", GroupIds: []string{"group-sandbox"}, AccountId: "222222222222", PermissionArn: testReadOnlyArn},
+			wantReason: true,
+		},
+		"policy of another user": {
+			request:    accessRequest{UserId: "user-john", AccountId: "333333333333", PermissionArn: testReadOnlyArn},
+			wantReason: true,
+		},
+		"duration too long": {
+			request:              accessRequest{UserId: "user-john", GroupIds: []string{"group-platform"}, AccountId: "111111111111", PermissionArn: testAdminArn, Duration: 6},
+			wantApprovalRequired: true,
+			wantMaxDuration:      4,
+			wantEligibilityIds:   []string{"group-platform"},
+			wantApproverSources:  []string{"111111111111", "ou-abcd-workload"},
+			wantReason:           true,
+		},
+		"no approvers": {
+			request:              accessRequest{UserId: "user-jane", AccountId: "333333333333", PermissionArn: testReadOnlyArn},
+			wantApprovalRequired: true,
+			wantMaxDuration:      2,
+			wantEligibilityIds:   []string{"user-jane"},
+			wantApproverSources:  []string{},
+			wantReason:           true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			data := fixture.data(t, tc.request.AccountId)
+
+			if tc.settings != nil {
+				data.Settings = tc.settings
+			}
+
+			got := evaluateAccess(tc.request, data)
+
+			if got.Allowed != tc.wantAllowed || got.ApprovalRequired != tc.wantApprovalRequired || got.MaxDuration != tc.wantMaxDuration {
+				t.Errorf("got allowed %t, approval required %t and max duration %d, want %t, %t and %d",
+					got.Allowed, got.ApprovalRequired, got.MaxDuration, tc.wantAllowed, tc.wantApprovalRequired, tc.wantMaxDuration)
+			}
+
+			if fmt.Sprint(got.EligibilityIds) != fmt.Sprint(tc.wantEligibilityIds) {
+				t.Errorf("got eligibility ids %v, want %v", got.EligibilityIds, tc.wantEligibilityIds)
+			}
+
+			sources := []string{}
+
+			for _, policy := range got.Approvers {
+				sources = append(sources, *policy.Id)
+			}
+
+			if tc.wantApproverSources != nil && fmt.Sprint(sources) != fmt.Sprint(tc.wantApproverSources) {
+				t.Errorf("got approvers from %v, want %v", sources, tc.wantApproverSources)
+			}
+
+			if tc.wantApproverSources == nil && len(sources) > 0 {
+				t.Errorf("got approvers from %v, want none", sources)
+			}
+
+			if (got.Reason != "") != tc.wantReason {
+				t.Errorf("got reason %q", got.Reason)
+			}
+		})
+	}
+}
+
+func TestEvaluateAccess_withoutSettings(t *testing.T) {
+	fixture := loadAccessFixture(t)
+	data := fixture.data(t, "222222222222")
+	data.Settings = nil
+
+	// The sandbox policy has no duration, so the default of the settings would apply.
+	data.Eligibilities = []*awsteam.Eligibility{
+		{
+			Id:               ptr.String("group-sandbox"),
+			Type:             ptr.String(EligibilityGroupType),
+			ApprovalRequired: ptr.Bool(false),
+			Accounts:         []*awsteam.EligibilityAccount{{Id: ptr.String("222222222222")}},
+			Permissions:      []*awsteam.EligibilityPermission{{Id: ptr.String(testAdminArn)}},
+		},
+	}
+
+	got := evaluateAccess(accessRequest{GroupIds: []string{"group-sandbox"}, AccountId: "222222222222", PermissionArn: testAdminArn, Duration: 24}, data)
+
+	if !got.Allowed || got.ApprovalRequired || got.MaxDuration != 0 {
+		t.Errorf("got %+v", got)
+	}
+}
+
+func TestAccessEvaluationModel_flatten(t *testing.T) {
+	ctx := context.Background()
+	fixture := loadAccessFixture(t)
+	request := accessRequest{UserId: "user-john", GroupIds: []string{"group-platform"}, AccountId: "111111111111", PermissionArn: testAdminArn}
+
+	var data AccessEvaluationModel
+
+	if diags := data.flatten(evaluateAccess(request, fixture.data(t, request.AccountId))); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if !data.Allowed.ValueBool() || !data.ApprovalRequired.ValueBool() || data.MaxDuration.ValueInt64() != 4 || data.Reason.ValueString() != "" {
+		t.Errorf("got allowed %s, approval required %s, max duration %s and reason %s", data.Allowed, data.ApprovalRequired, data.MaxDuration, data.Reason)
+	}
+
+	var groupIds []string
+	if diags := data.ApproverGroupIds.ElementsAs(ctx, &groupIds, false); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if len(groupIds) != 2 || len(data.Approvers.Elements()) != 2 {
+		t.Errorf("got approvers %s and group ids %v", data.Approvers, groupIds)
+	}
+
+	denied := AccessEvaluationModel{}
+
+	if diags := denied.flatten(accessDecision{Reason: "denied"}); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if denied.Allowed.ValueBool() || !denied.MaxDuration.IsNull() || !denied.Reason.Equal(types.StringValue("denied")) {
+		t.Errorf("got allowed %s, max duration %s and reason %s", denied.Allowed, denied.MaxDuration, denied.Reason)
+	}
+}
@@ -0,0 +1,508 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/smithy-go/ptr"
+	"github.com/awsteam-contrib/terraform-provider-awsteam/internal/sdk/awsteam"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// The status values of a TEAM request.
+const (
+	RequestStatusPending    = "pending"
+	RequestStatusApproved   = "approved"
+	RequestStatusRejected   = "rejected"
+	RequestStatusCancelled  = "cancelled"
+	RequestStatusScheduled  = "scheduled"
+	RequestStatusInProgress = "in progress"
+	RequestStatusEnded      = "ended"
+	RequestStatusExpired    = "expired"
+	RequestStatusRevoked    = "revoked"
+	RequestStatusError      = "error"
+)
+
+const accessRequestRevokeComment = "Revoked by terraform."
+
+var _ resource.Resource = &AccessRequestResource{}
+var _ resource.ResourceWithImportState = &AccessRequestResource{}
+var _ resource.ResourceWithValidateConfig = &AccessRequestResource{}
+
+func NewAccessRequestResource() resource.Resource {
+	return &AccessRequestResource{}
+}
+
+type AccessRequestResource struct {
+	client *awsteam.Client
+	lookup *eligibilityLookup
+}
+
+type AccessRequestModel struct {
+	Id             types.String `tfsdk:"id"`
+	UserName       types.String `tfsdk:"user_name"`
+	Email          types.String `tfsdk:"email"`
+	AccountId      types.String `tfsdk:"account_id"`
+	AccountName    types.String `tfsdk:"account_name"`
+	PermissionArn  types.String `tfsdk:"permission_arn"`
+	PermissionName types.String `tfsdk:"permission_name"`
+	Duration       types.Int64  `tfsdk:"duration"`
+	StartTime      types.String `tfsdk:"start_time"`
+	Justification  types.String `tfsdk:"justification"`
+	TicketNo       types.String `tfsdk:"ticket_no"`
+	Status         types.String `tfsdk:"status"`
+	EndTime        types.String `tfsdk:"end_time"`
+	Approver       types.String `tfsdk:"approver"`
+	Revoker        types.String `tfsdk:"revoker"`
+	CreatedAt      types.String `tfsdk:"created_at"`
+	UpdatedAt      types.String `tfsdk:"updated_at"`
+}
+
+func (r *AccessRequestResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_access_request"
+}
+
+func (r *AccessRequestResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Submits an elevated access request to an AWS TEAM deployment. " +
+			"TEAM decides whether the request has to be approved and starts the session once it is approved or does not need approval; `status` follows the request through its lifecycle.\n\n" +
+			"Any change replaces the request. Destroying the resource cancels a pending request and revokes an approved, scheduled or active one. Requests that have already ended are left as they are.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The id of the request.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"user_name": schema.StringAttribute{
+				MarkdownDescription: "The IAM Identity Center user name of the user access is requested for.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"email": schema.StringAttribute{
+				MarkdownDescription: "The email address of the user access is requested for. TEAM sends notifications about the request to this address.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"account_id": schema.StringAttribute{
+				MarkdownDescription: "The AWS account id access is requested for.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(
+						regexache.MustCompile(`^\d{12}$`),
+						"value must be a valid aws account id.",
+					),
+				},
+			},
+			"account_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the AWS account access is requested for. When omitted, the name is looked up in the accounts known to AWS TEAM.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"permission_arn": schema.StringAttribute{
+				MarkdownDescription: "The ARN of the requested permission set.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(
+						regexache.MustCompile(`^arn:(aws|aws-us-gov|aws-cn|aws-iso|aws-iso-b):sso:::permissionSet/(sso)?ins-[a-zA-Z0-9-.]{16}/ps-[a-zA-Z0-9-./]{16}$`),
+						"value must be a valid AWS permissionSet ARN.",
+					),
+				},
+			},
+			"permission_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the requested permission set. When omitted, the name is looked up in the permission sets known to AWS TEAM.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"duration": schema.Int64Attribute{
+				MarkdownDescription: "The requested duration of the session in hours.",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"start_time": schema.StringAttribute{
+				MarkdownDescription: "When the session should start, in RFC 3339 format. Defaults to the time the request is created.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"justification": schema.StringAttribute{
+				MarkdownDescription: "Why access is needed. Required when comments are mandatory in the TEAM settings.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"ticket_no": schema.StringAttribute{
+				MarkdownDescription: "The Change Management system ticket system number. Required when ticket numbers are mandatory in the TEAM settings.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "The status of the request, one of `pending`, `approved`, `rejected`, `cancelled`, `scheduled`, `in progress`, `ended`, `expired`, `revoked` or `error`.",
+				Computed:            true,
+			},
+			"end_time": schema.StringAttribute{
+				MarkdownDescription: "When the session ended or will end.",
+				Computed:            true,
+			},
+			"approver": schema.StringAttribute{
+				MarkdownDescription: "The user that approved or rejected the request.",
+				Computed:            true,
+			},
+			"revoker": schema.StringAttribute{
+				MarkdownDescription: "The user that revoked the session.",
+				Computed:            true,
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "The date and time that the item was created",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "The date and time of the last time the item was updated",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *AccessRequestResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data AccessRequestModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() || data.StartTime.IsNull() || data.StartTime.IsUnknown() {
+		return
+	}
+
+	if _, err := time.Parse(time.RFC3339, data.StartTime.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("start_time"),
+			"Invalid Start Time",
+			fmt.Sprintf("The value of start_time is not a valid RFC 3339 time: %s", err),
+		)
+	}
+}
+
+func (r *AccessRequestResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	meta, ok := req.ProviderData.(*AWSTEAMClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.AWSTEAMClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = meta.Client
+	r.lookup = meta.eligibilityLookup()
+}
+
+func (r *AccessRequestResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data AccessRequestModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in, diags := expandAccessRequest(ctx, r.lookup, data)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := r.client.CreateRequest(ctx, in)
+
+	if err != nil {
+		resp.Diagnostics.Append(clientErrorDiagnostic("Unable to create access request", err))
+		return
+	}
+
+	if out == nil || out.Request == nil {
+		resp.Diagnostics.AddError("Create Error", "Received empty Request.")
+		return
+	}
+
+	data.flatten(out.Request)
+
+	tflog.Trace(ctx, "created access request resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccessRequestResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data AccessRequestModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in := &awsteam.GetRequestInput{
+		Id: data.Id.ValueStringPointer(),
+	}
+
+	out, err := r.client.GetRequest(ctx, in)
+
+	if isNotFound(err) {
+		resp.Diagnostics.AddWarning("Read Error", "Received empty Request. Removing from state.")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.Append(clientErrorDiagnostic("Unable to read access request", err))
+		return
+	}
+
+	data.flatten(out.Request)
+
+	tflog.Trace(ctx, "read access request resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccessRequestResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Every configurable attribute requires replacement.
+	resp.Diagnostics.AddError("Update Error", "Access requests cannot be updated. Please report this issue to the provider developers.")
+}
+
+func (r *AccessRequestResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data AccessRequestModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(endAccessRequest(ctx, r.client, data.Id.ValueString())...)
+}
+
+func (r *AccessRequestResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// expandAccessRequest returns the input creating the request of data. The
+// account and permission set names are looked up when they are not set.
+func expandAccessRequest(ctx context.Context, lookup *eligibilityLookup, data AccessRequestModel) (*awsteam.CreateRequestInput, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	in := &awsteam.CreateRequestInput{
+		Username:      data.UserName.ValueStringPointer(),
+		Email:         data.Email.ValueStringPointer(),
+		AccountId:     data.AccountId.ValueStringPointer(),
+		AccountName:   data.AccountName.ValueStringPointer(),
+		Role:          data.PermissionName.ValueStringPointer(),
+		RoleId:        data.PermissionArn.ValueStringPointer(),
+		StartTime:     data.StartTime.ValueStringPointer(),
+		Duration:      data.Duration.ValueInt64Pointer(),
+		Justification: data.Justification.ValueStringPointer(),
+		TicketNo:      data.TicketNo.ValueStringPointer(),
+		Status:        ptr.String(RequestStatusPending),
+	}
+
+	if data.AccountName.IsNull() || data.AccountName.IsUnknown() {
+		in.AccountName = lookupName(ctx, lookup.accountNames, data.AccountId.ValueString(), path.Root("account_id"), "account", &diags)
+	}
+
+	if data.PermissionName.IsNull() || data.PermissionName.IsUnknown() {
+		in.Role = lookupName(ctx, lookup.permissionNames, data.PermissionArn.ValueString(), path.Root("permission_arn"), "permission set", &diags)
+	}
+
+	if data.StartTime.IsNull() || data.StartTime.IsUnknown() {
+		in.StartTime = ptr.String(time.Now().UTC().Format(time.RFC3339))
+	}
+
+	return in, diags
+}
+
+// lookupName returns the name list returns for id, adding an attribute error
+// at idPath to diags when there is none.
+func lookupName(ctx context.Context, list func(context.Context) (map[string]string, error), id string, idPath path.Path, noun string, diags *diag.Diagnostics) *string {
+	names, err := list(ctx)
+
+	if err != nil {
+		diags.Append(clientErrorDiagnostic(fmt.Sprintf("Unable to look up the %s name", noun), err))
+		return nil
+	}
+
+	name, ok := names[id]
+
+	if !ok {
+		diags.AddAttributeError(idPath, "Unknown Id", fmt.Sprintf("The %s %q is not known to AWS TEAM, so its name cannot be looked up.", noun, id))
+		return nil
+	}
+
+	return &name
+}
+
+// endAccessRequest cancels the request with the given id when it is pending
+// and revokes it when it is approved, scheduled or active. Requests that have
+// already ended or no longer exist are left as they are.
+func endAccessRequest(ctx context.Context, client *awsteam.Client, id string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	out, err := client.GetRequest(ctx, &awsteam.GetRequestInput{Id: &id})
+
+	if isNotFound(err) {
+		return diags
+	}
+
+	if err != nil {
+		diags.Append(clientErrorDiagnostic("Unable to read access request", err))
+		return diags
+	}
+
+	status, ok := accessRequestEndStatus(ptr.ToString(out.Request.Status))
+
+	if !ok {
+		tflog.Debug(ctx, "access request already ended", map[string]interface{}{"id": id, "status": ptr.ToString(out.Request.Status)})
+		return diags
+	}
+
+	in := &awsteam.UpdateRequestInput{
+		Id:     &id,
+		Status: ptr.String(status),
+	}
+
+	if status == RequestStatusRevoked {
+		in.RevokeComment = ptr.String(accessRequestRevokeComment)
+	}
+
+	_, err = client.UpdateRequest(ctx, in)
+
+	if err != nil && !isAlreadyDeleted(err) {
+		diags.Append(clientErrorDiagnostic(fmt.Sprintf("Unable to set the access request to %s", status), err))
+	}
+
+	return diags
+}
+
+// accessRequestEndStatus returns the status that ends a request in the given
+// status, and false if the request has already ended.
+func accessRequestEndStatus(status string) (string, bool) {
+	switch status {
+	case RequestStatusPending:
+		return RequestStatusCancelled, true
+	case RequestStatusApproved, RequestStatusScheduled, RequestStatusInProgress:
+		return RequestStatusRevoked, true
+	}
+
+	return "", false
+}
+
+func (d *AccessRequestModel) flatten(out *awsteam.Request) {
+	d.Id = types.StringPointerValue(out.Id)
+	d.UserName = types.StringPointerValue(out.Username)
+	d.Email = types.StringPointerValue(out.Email)
+	d.AccountId = types.StringPointerValue(out.AccountId)
+	d.AccountName = types.StringPointerValue(out.AccountName)
+	d.PermissionArn = types.StringPointerValue(out.RoleId)
+	d.PermissionName = types.StringPointerValue(out.Role)
+	d.Duration = types.Int64PointerValue(out.Duration)
+
+	// TEAM may return the start time in another format, so the configured
+	// value is kept when it is the same time.
+	if !sameTime(d.StartTime.ValueString(), ptr.ToString(out.StartTime)) {
+		d.StartTime = types.StringPointerValue(out.StartTime)
+	}
+
+	d.Justification = types.StringPointerValue(out.Justification)
+	d.TicketNo = types.StringPointerValue(out.TicketNo)
+	d.Status = types.StringPointerValue(out.Status)
+	d.EndTime = types.StringPointerValue(out.EndTime)
+	d.Approver = types.StringPointerValue(out.Approver)
+	d.Revoker = types.StringPointerValue(out.Revoker)
+	d.CreatedAt = types.StringPointerValue(out.CreatedAt)
+	d.UpdatedAt = types.StringPointerValue(out.UpdatedAt)
+}
+
+// sameTime returns whether a and b are RFC 3339 times of the same instant.
+func sameTime(a, b string) bool {
+	t1, err := time.Parse(time.RFC3339, a)
+
+	if err != nil {
+		return false
+	}
+
+	t2, err := time.Parse(time.RFC3339, b)
+
+	return err == nil && t1.Equal(t2)
+}
@@ -0,0 +1,227 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/aws/smithy-go/ptr"
+	"github.com/awsteam-contrib/terraform-provider-awsteam/internal/sdk/awsteam"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccAccessRequestResource_basic(t *testing.T) {
+	resourceName := "awsteam_access_request.test"
+	user := gofakeit.Email()
+	permissionArn := "arn:aws:sso:::permissionSet/ssoins-4334d1f197f50907/ps-f5ge203d3d2428d3" // hard coded fake arn
+	justification := gofakeit.Sentence(5)
+
+	// This environment variable should be set to the id of an account of the organization.
+	expectedAccountsIdVar := "AWSTEAM_TESTS_EXPECTED_ACCOUNT_ID"
+	expectedAccountsId := os.Getenv(expectedAccountsIdVar)
+	if expectedAccountsId == "" {
+		t.Skipf("Skipping Access Request Tests, Environment variable %s is not set.", expectedAccountsIdVar)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccessRequestResourceConfig(user, expectedAccountsId, permissionArn, justification),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "user_name", user),
+					resource.TestCheckResourceAttr(resourceName, "account_id", expectedAccountsId),
+					resource.TestCheckResourceAttr(resourceName, "permission_arn", permissionArn),
+					resource.TestCheckResourceAttr(resourceName, "duration", "1"),
+					resource.TestCheckResourceAttr(resourceName, "justification", justification),
+					resource.TestCheckResourceAttrSet(resourceName, "start_time"),
+					resource.TestCheckResourceAttrSet(resourceName, "status"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_at"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"status", "end_time", "updated_at"},
+			},
+		},
+	})
+}
+
+func testAccAccessRequestResourceConfig(user, accountId, permissionArn, justification string) string {
+	return fmt.Sprintf(`
+resource "awsteam_access_request" "test" {
+  user_name       = %[1]q
+  email           = %[1]q
+  account_id      = %[2]q
+  account_name    = "test-account"
+  permission_arn  = %[3]q
+  permission_name = "test-permission"
+  duration        = 1
+  justification   = %[4]q
+}
+`, user, accountId, permissionArn, justification)
+}
+
+func TestAccAccessRequestResource_startTime(t *testing.T) {
+	resourceName := "awsteam_access_request.test"
+	user := gofakeit.Email()
+	permissionArn := "arn:aws:sso:::permissionSet/ssoins-4334d1f197f50907/ps-f5ge203d3d2428d3" // hard coded fake arn
+	startTime := time.Now().Add(24 * time.Hour).UTC().Truncate(time.Second).Format(time.RFC3339)
+
+	// This environment variable should be set to the id of an account of the organization.
+	expectedAccountsIdVar := "AWSTEAM_TESTS_EXPECTED_ACCOUNT_ID"
+	expectedAccountsId := os.Getenv(expectedAccountsIdVar)
+	if expectedAccountsId == "" {
+		t.Skipf("Skipping Access Request Tests, Environment variable %s is not set.", expectedAccountsIdVar)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccessRequestResourceConfig_startTime(user, expectedAccountsId, permissionArn, startTime),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "user_name", user),
+					resource.TestCheckResourceAttr(resourceName, "start_time", startTime),
+					resource.TestCheckResourceAttrSet(resourceName, "status"),
+				),
+			},
+			{
+				Config:   testAccAccessRequestResourceConfig_startTime(user, expectedAccountsId, permissionArn, startTime),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func testAccAccessRequestResourceConfig_startTime(user, accountId, permissionArn, startTime string) string {
+	return fmt.Sprintf(`
+resource "awsteam_access_request" "test" {
+  user_name      = %[1]q
+  account_id     = %[2]q
+  permission_arn = %[3]q
+  duration       = 1
+  start_time     = %[4]q
+}
+`, user, accountId, permissionArn, startTime)
+}
+
+func TestAccessRequestModel_flatten(t *testing.T) {
+	var data AccessRequestModel
+
+	data.flatten(&awsteam.Request{
+		Id:          ptr.String("0f3c1d5e-4c52-4d0e-9c7a-5d0b6b1a2f3e"),
+		Username:    ptr.String("jane@example.com"),
+		AccountId:   ptr.String("123456789012"),
+		AccountName: ptr.String("production"),
+		Role:        ptr.String("Admin"),
+		RoleId:      ptr.String(testAdminArn),
+		Duration:    ptr.Int64(2),
+		StartTime:   ptr.String("2024-05-01T10:00:00Z"),
+		Status:      ptr.String(RequestStatusApproved),
+		Approver:    ptr.String("john@example.com"),
+	})
+
+	checks := map[string][2]string{
+		"id":              {data.Id.ValueString(), "0f3c1d5e-4c52-4d0e-9c7a-5d0b6b1a2f3e"},
+		"user_name":       {data.UserName.ValueString(), "jane@example.com"},
+		"account_name":    {data.AccountName.ValueString(), "production"},
+		"permission_arn":  {data.PermissionArn.ValueString(), testAdminArn},
+		"permission_name": {data.PermissionName.ValueString(), "Admin"},
+		"status":          {data.Status.ValueString(), RequestStatusApproved},
+		"approver":        {data.Approver.ValueString(), "john@example.com"},
+	}
+
+	for attr, check := range checks {
+		if check[0] != check[1] {
+			t.Errorf("%s = %q, want %q", attr, check[0], check[1])
+		}
+	}
+
+	if data.Duration != types.Int64Value(2) {
+		t.Errorf("duration = %s, want 2", data.Duration)
+	}
+
+	if !data.Email.IsNull() || !data.TicketNo.IsNull() || !data.Revoker.IsNull() {
+		t.Errorf("expected unset attributes to be null, got email %s, ticket_no %s, revoker %s", data.Email, data.TicketNo, data.Revoker)
+	}
+}
+
+func TestAccessRequestEndStatus(t *testing.T) {
+	tests := []struct {
+		status string
+		want   string
+		ok     bool
+	}{
+		{RequestStatusPending, RequestStatusCancelled, true},
+		{RequestStatusApproved, RequestStatusRevoked, true},
+		{RequestStatusScheduled, RequestStatusRevoked, true},
+		{RequestStatusInProgress, RequestStatusRevoked, true},
+		{RequestStatusRejected, "", false},
+		{RequestStatusCancelled, "", false},
+		{RequestStatusEnded, "", false},
+		{RequestStatusExpired, "", false},
+		{RequestStatusRevoked, "", false},
+		{RequestStatusError, "", false},
+		{"", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.status, func(t *testing.T) {
+			got, ok := accessRequestEndStatus(tt.status)
+
+			if got != tt.want || ok != tt.ok {
+				t.Errorf("accessRequestEndStatus(%q) = %q, %t, want %q, %t", tt.status, got, ok, tt.want, tt.ok)
+			}
+		})
+	}
+}
+
+func TestAccessRequestModel_flattenStartTime(t *testing.T) {
+	tests := []struct {
+		name      string
+		planned   types.String
+		returned  string
+		wantStart string
+	}{
+		{
+			name:      "same time in another format",
+			planned:   types.StringValue("2024-05-01T12:00:00+02:00"),
+			returned:  "2024-05-01T10:00:00.000Z",
+			wantStart: "2024-05-01T12:00:00+02:00",
+		},
+		{
+			name:      "different time",
+			planned:   types.StringValue("2024-05-01T12:00:00Z"),
+			returned:  "2024-05-01T10:00:00.000Z",
+			wantStart: "2024-05-01T10:00:00.000Z",
+		},
+		{
+			name:      "unknown",
+			planned:   types.StringUnknown(),
+			returned:  "2024-05-01T10:00:00.000Z",
+			wantStart: "2024-05-01T10:00:00.000Z",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := AccessRequestModel{StartTime: tt.planned}
+
+			data.flatten(&awsteam.Request{StartTime: ptr.String(tt.returned)})
+
+			if got := data.StartTime.ValueString(); got != tt.wantStart {
+				t.Errorf("start_time = %q, want %q", got, tt.wantStart)
+			}
+		})
+	}
+}
@@ -72,18 +72,18 @@ func (d *AccountsDataSource) Configure(ctx context.Context, req datasource.Confi
 		return
 	}
 
-	client, ok := req.ProviderData.(*awsteam.Client)
+	meta, ok := req.ProviderData.(*AWSTEAMClient)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *awsteam.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *provider.AWSTEAMClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	d.client = client
+	d.client = meta.Client
 }
 
 func (d *AccountsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -100,7 +100,7 @@ func (d *AccountsDataSource) Read(ctx context.Context, req datasource.ReadReques
 	out, err := d.client.GetAccounts(ctx, in)
 
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read settings, got error: %s", err))
+		resp.Diagnostics.Append(clientErrorDiagnostic("Unable to read accounts", err))
 		return
 	}
 
@@ -110,7 +110,7 @@ func (d *AccountsDataSource) Read(ctx context.Context, req datasource.ReadReques
 	}
 
 	data.flatten(out)
-	tflog.Trace(ctx, "read settings resource")
+	tflog.Trace(ctx, "read accounts data source")
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
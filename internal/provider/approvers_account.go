@@ -72,10 +72,7 @@ func (r *ApproversAccountResource) Schema(ctx context.Context, req resource.Sche
 					stringplanmodifier.RequiresReplace(),
 				},
 				Validators: []validator.String{
-					stringvalidator.RegexMatches(
-						regexache.MustCompile(`[\s\S]*`),
-						"value must be a valid aws account name.",
-					),
+					stringvalidator.LengthAtLeast(1),
 				},
 			},
 			"account_id": schema.StringAttribute{
@@ -86,7 +83,7 @@ func (r *ApproversAccountResource) Schema(ctx context.Context, req resource.Sche
 				},
 				Validators: []validator.String{
 					stringvalidator.RegexMatches(
-						regexache.MustCompile(`\d{12}`),
+						regexache.MustCompile(`^\d{12}$`),
 						"value must be a valid aws account id.",
 					),
 				},
@@ -122,18 +119,18 @@ func (r *ApproversAccountResource) Configure(ctx context.Context, req resource.C
 		return
 	}
 
-	client, ok := req.ProviderData.(*awsteam.Client)
+	meta, ok := req.ProviderData.(*AWSTEAMClient)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *awsteam.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *provider.AWSTEAMClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = client
+	r.client = meta.Client
 }
 
 func (r *ApproversAccountResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -170,7 +167,7 @@ func (r *ApproversAccountResource) Create(ctx context.Context, req resource.Crea
 	out, err := r.client.CreateApprovers(ctx, in)
 
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create approvers ou, got error: %s", err))
+		resp.Diagnostics.Append(clientErrorDiagnostic("Unable to create approvers account", err))
 		return
 	}
 
@@ -211,8 +208,14 @@ func (r *ApproversAccountResource) Read(ctx context.Context, req resource.ReadRe
 
 	out, err := r.client.GetApprovers(ctx, in)
 
+	if isNotFound(err) {
+		resp.Diagnostics.AddWarning("Read Error", "Received empty Approvers. Removing from state.")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read approvers ou policy, got error: %s", err))
+		resp.Diagnostics.Append(clientErrorDiagnostic("Unable to read approvers account policy", err))
 		return
 	}
 
@@ -233,7 +236,7 @@ func (r *ApproversAccountResource) Read(ctx context.Context, req resource.ReadRe
 		return
 	}
 
-	tflog.Trace(ctx, "read approvers ou resource")
+	tflog.Trace(ctx, "read approvers account resource")
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -301,7 +304,7 @@ func (r *ApproversAccountResource) Update(ctx context.Context, req resource.Upda
 		out, err := r.client.UpdateApprovers(ctx, in)
 
 		if err != nil {
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update approvers ou, got error: %s", err))
+			resp.Diagnostics.Append(clientErrorDiagnostic("Unable to update approvers account", err))
 			return
 		}
 
@@ -322,7 +325,7 @@ func (r *ApproversAccountResource) Update(ctx context.Context, req resource.Upda
 			return
 		}
 
-		tflog.Trace(ctx, "updated approvers ou resource")
+		tflog.Trace(ctx, "updated approvers account resource")
 
 	}
 
@@ -344,8 +347,8 @@ func (r *ApproversAccountResource) Delete(ctx context.Context, req resource.Dele
 
 	_, err := r.client.DeleteApprovers(ctx, in)
 
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete approvers ou, got error: %s", err))
+	if err != nil && !isAlreadyDeleted(err) {
+		resp.Diagnostics.Append(clientErrorDiagnostic("Unable to delete approvers account", err))
 		return
 	}
 }
@@ -4,18 +4,18 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/brianvoe/gofakeit/v6"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
 func TestAccApproversAccountResource_basic(t *testing.T) {
+	faker := testAccFaker(t)
 	resourceName := "awsteam_approvers_account.test"
-	accountId := gofakeit.DigitN(12)
-	accountName := gofakeit.BS()
-	approver1 := gofakeit.Email()
-	groupId1 := gofakeit.UUID()
-	approver2 := gofakeit.Email()
-	groupId2 := gofakeit.UUID()
+	accountId := faker.DigitN(12)
+	accountName := faker.BS()
+	approver1 := faker.Email()
+	groupId1 := faker.UUID()
+	approver2 := faker.Email()
+	groupId2 := faker.UUID()
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -50,11 +50,12 @@ func TestAccApproversAccountResource_basic(t *testing.T) {
 }
 
 func TestAccApproversAccountResource_accountId(t *testing.T) {
+	faker := testAccFaker(t)
 	resourceName := "awsteam_approvers_account.test"
 	accountIdLeadingZeros := "000000123456"
-	accountName := gofakeit.BS()
-	approver1 := gofakeit.Email()
-	groupId1 := gofakeit.UUID()
+	accountName := faker.BS()
+	approver1 := faker.Email()
+	groupId1 := faker.UUID()
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -0,0 +1,323 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/smithy-go/ptr"
+	"github.com/awsteam-contrib/terraform-provider-awsteam/internal/sdk/awsteam"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	approverAttrTypes = map[string]attr.Type{
+		"group_id":    types.StringType,
+		"group_name":  types.StringType,
+		"source_id":   types.StringType,
+		"source_name": types.StringType,
+		"source_type": types.StringType,
+	}
+)
+
+var _ datasource.DataSource = &ApproversDataSource{}
+
+func NewApproversDataSource() datasource.DataSource {
+	return &ApproversDataSource{}
+}
+
+type ApproversDataSource struct {
+	client *awsteam.Client
+}
+
+type ApproversModel struct {
+	Id        types.String `tfsdk:"id"`
+	AccountId types.String `tfsdk:"account_id"`
+	Approvers types.List   `tfsdk:"approvers"`
+	GroupIds  types.Set    `tfsdk:"group_ids"`
+}
+
+func (d *ApproversDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_approvers"
+}
+
+func (d *ApproversDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Provides a data source for the groups that approve elevated access requests for an AWS account. " +
+			"The approvers are those of the approvers policy of the account and of the policies of all OUs containing the account, up to the root.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The approvers identifier. This is the same as the account_id.",
+				Computed:            true,
+			},
+			"account_id": schema.StringAttribute{
+				MarkdownDescription: "The AWS account id to return the approvers for.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(
+						regexache.MustCompile(`^\d{12}$`),
+						"value must be a valid aws account id.",
+					),
+				},
+			},
+			"approvers": schema.ListNestedAttribute{
+				MarkdownDescription: "The approver groups with the policy each one comes from. The groups of the account policy are listed first, followed by those of the parent OU and its ancestors. A group approving through more than one policy is listed once per policy.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"group_id": schema.StringAttribute{
+							MarkdownDescription: "The id of the approver group.",
+							Computed:            true,
+						},
+						"group_name": schema.StringAttribute{
+							MarkdownDescription: "The name of the approver group.",
+							Computed:            true,
+						},
+						"source_id": schema.StringAttribute{
+							MarkdownDescription: "The account, OU or root id of the approvers policy the group comes from.",
+							Computed:            true,
+						},
+						"source_name": schema.StringAttribute{
+							MarkdownDescription: "The account or OU name of the approvers policy the group comes from.",
+							Computed:            true,
+						},
+						"source_type": schema.StringAttribute{
+							MarkdownDescription: "The type of the approvers policy the group comes from, either `Account` or `OU`.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"group_ids": schema.SetAttribute{
+				MarkdownDescription: "The distinct ids of all approver groups.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *ApproversDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	meta, ok := req.ProviderData.(*AWSTEAMClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.AWSTEAMClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = meta.Client
+}
+
+func (d *ApproversDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ApproversModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	accountId := data.AccountId.ValueString()
+
+	ancestors, diags := readAccountAncestors(ctx, d.client, accountId)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policies, err := listApprovers(ctx, d.client)
+
+	if err != nil {
+		resp.Diagnostics.Append(clientErrorDiagnostic("Unable to read approvers", err))
+		return
+	}
+
+	resp.Diagnostics.Append(data.flatten(accountApproverPolicies(accountId, ancestors, policies))...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "read approvers data source")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *ApproversModel) flatten(policies []*awsteam.Approvers) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	elems := []attr.Value{}
+	groupIds := []attr.Value{}
+	seen := map[string]bool{}
+
+	for _, policy := range policies {
+		for i := range max(len(policy.GroupIds), len(policy.Approvers)) {
+			var groupId, groupName *string
+
+			if i < len(policy.GroupIds) {
+				groupId = policy.GroupIds[i]
+			}
+
+			if i < len(policy.Approvers) {
+				groupName = policy.Approvers[i]
+			}
+
+			obj, objDiags := types.ObjectValue(approverAttrTypes, map[string]attr.Value{
+				"group_id":    types.StringPointerValue(groupId),
+				"group_name":  types.StringPointerValue(groupName),
+				"source_id":   types.StringPointerValue(policy.Id),
+				"source_name": types.StringPointerValue(policy.Name),
+				"source_type": types.StringPointerValue(policy.Type),
+			})
+			diags.Append(objDiags...)
+
+			elems = append(elems, obj)
+
+			if groupId != nil && !seen[*groupId] {
+				seen[*groupId] = true
+				groupIds = append(groupIds, types.StringPointerValue(groupId))
+			}
+		}
+	}
+
+	if diags.HasError() {
+		return diags
+	}
+
+	list, listDiags := types.ListValue(types.ObjectType{AttrTypes: approverAttrTypes}, elems)
+	diags.Append(listDiags...)
+
+	set, setDiags := types.SetValue(types.StringType, groupIds)
+	diags.Append(setDiags...)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	d.Id = d.AccountId
+	d.Approvers = list
+	d.GroupIds = set
+
+	return diags
+}
+
+// readAccountAncestors returns the OUs containing the account, starting with
+// its parent and ending with the root of the organization.
+func readAccountAncestors(ctx context.Context, client *awsteam.Client, accountId string) ([]*awsteam.OU, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	parent, err := client.GetOU(ctx, &awsteam.GetOUInput{Id: ptr.String(accountId)})
+
+	if isNotFound(err) {
+		diags.AddAttributeError(
+			path.Root("account_id"),
+			"Unknown Account",
+			fmt.Sprintf("The account %q is not part of the organization known to AWS TEAM.", accountId),
+		)
+		return nil, diags
+	}
+
+	if err != nil {
+		diags.Append(clientErrorDiagnostic("Unable to read the parent of the account", err))
+		return nil, diags
+	}
+
+	out, err := client.GetOUs(ctx, &awsteam.GetOUsInput{})
+
+	if err != nil {
+		diags.Append(clientErrorDiagnostic("Unable to read organizational units", err))
+		return nil, diags
+	}
+
+	ancestors := ancestorsOf(out.Root, *parent.OU.Id)
+
+	if ancestors == nil {
+		diags.AddError(
+			"Unknown Organizational Unit",
+			fmt.Sprintf("The parent %q of the account %q is not part of the organizational units known to AWS TEAM.", *parent.OU.Id, accountId),
+		)
+		return nil, diags
+	}
+
+	return ancestors, diags
+}
+
+// ancestorsOf returns the OU with the given id and its ancestors below root,
+// nearest first. OUs without an id are left out. It returns nil if the tree
+// below root contains no such OU.
+func ancestorsOf(root *awsteam.OU, id string) []*awsteam.OU {
+	ous := root.PathTo(id)
+
+	if ous == nil {
+		return nil
+	}
+
+	ancestors := []*awsteam.OU{}
+
+	for i := len(ous) - 1; i >= 0; i-- {
+		if ous[i].Id != nil {
+			ancestors = append(ancestors, ous[i])
+		}
+	}
+
+	return ancestors
+}
+
+// listApprovers returns all approvers policies keyed by account or OU id.
+func listApprovers(ctx context.Context, client *awsteam.Client) (map[string]*awsteam.Approvers, error) {
+	policies := map[string]*awsteam.Approvers{}
+
+	paginator := awsteam.NewListApproversPaginator(client, &awsteam.ListApproversInput{})
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, approvers := range page.Approvers {
+			if approvers != nil && approvers.Id != nil {
+				policies[*approvers.Id] = approvers
+			}
+		}
+	}
+
+	return policies, nil
+}
+
+// accountApproverPolicies returns the approvers policies that apply to the
+// account: its own policy followed by those of its ancestors, nearest first.
+func accountApproverPolicies(accountId string, ancestors []*awsteam.OU, policies map[string]*awsteam.Approvers) []*awsteam.Approvers {
+	applicable := []*awsteam.Approvers{}
+
+	if policy, ok := policies[accountId]; ok {
+		applicable = append(applicable, policy)
+	}
+
+	for _, ou := range ancestors {
+		if policy, ok := policies[*ou.Id]; ok {
+			applicable = append(applicable, policy)
+		}
+	}
+
+	return applicable
+}
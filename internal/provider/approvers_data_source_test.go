@@ -0,0 +1,118 @@
+package provider
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/aws/smithy-go/ptr"
+	"github.com/awsteam-contrib/terraform-provider-awsteam/internal/sdk/awsteam"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccApproversDataSource_basic(t *testing.T) {
+	dataSourceName := "data.awsteam_approvers.test"
+
+	// This environment variable should be set to the id of an account of the organization.
+	expectedAccountsIdVar := "AWSTEAM_TESTS_EXPECTED_ACCOUNT_ID"
+	expectedAccountsId := os.Getenv(expectedAccountsIdVar)
+	if expectedAccountsId == "" {
+		t.Skipf("Skipping Approvers Tests, Environment variable %s is not set.", expectedAccountsIdVar)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApproversDataSourceConfig(expectedAccountsId),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "id", expectedAccountsId),
+					resource.TestCheckResourceAttrSet(dataSourceName, "approvers.#"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "group_ids.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccApproversDataSourceConfig(accountId string) string {
+	return `
+data "awsteam_approvers" "test" {
+  account_id = "` + accountId + `"
+}`
+}
+
+func TestAccountApproverPolicies(t *testing.T) {
+	ctx := context.Background()
+
+	// r-root
+	// └── ou-workloads (Workloads)
+	//     └── ou-prod (Prod)
+	root := &awsteam.OU{
+		Id: ptr.String("r-root"),
+		Children: []awsteam.OU{
+			{Id: ptr.String("ou-workloads"), Name: ptr.String("Workloads"), Children: []awsteam.OU{
+				{Id: ptr.String("ou-prod"), Name: ptr.String("Prod")},
+			}},
+		},
+	}
+
+	policies := map[string]*awsteam.Approvers{
+		"123456789012": {Id: ptr.String("123456789012"), Name: ptr.String("production"), Type: ptr.String("Account"), Approvers: []*string{ptr.String("Owners")}, GroupIds: []*string{ptr.String("group-owners")}},
+		"ou-workloads": {Id: ptr.String("ou-workloads"), Name: ptr.String("Workloads"), Type: ptr.String("OU"), Approvers: []*string{ptr.String("Platform"), ptr.String("Owners")}, GroupIds: []*string{ptr.String("group-platform"), ptr.String("group-owners")}},
+		"r-root":       {Id: ptr.String("r-root"), Name: ptr.String("Root"), Type: ptr.String("OU"), Approvers: []*string{ptr.String("Security")}, GroupIds: []*string{ptr.String("group-security")}},
+		"ou-other":     {Id: ptr.String("ou-other"), Name: ptr.String("Other"), Type: ptr.String("OU"), Approvers: []*string{ptr.String("Other")}, GroupIds: []*string{ptr.String("group-other")}},
+	}
+
+	ancestors := ancestorsOf(root, "ou-prod")
+
+	if len(ancestors) != 3 || *ancestors[0].Id != "ou-prod" || *ancestors[2].Id != "r-root" {
+		t.Fatalf("got ancestors %v", ancestors)
+	}
+
+	if ancestorsOf(root, "ou-missing") != nil {
+		t.Error("got ancestors for an OU that is not in the tree")
+	}
+
+	data := ApproversModel{AccountId: types.StringValue("123456789012")}
+
+	if diags := data.flatten(accountApproverPolicies("123456789012", ancestors, policies)); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	type approver struct {
+		GroupId    string `tfsdk:"group_id"`
+		GroupName  string `tfsdk:"group_name"`
+		SourceId   string `tfsdk:"source_id"`
+		SourceName string `tfsdk:"source_name"`
+		SourceType string `tfsdk:"source_type"`
+	}
+
+	var approvers []approver
+	if diags := data.Approvers.ElementsAs(ctx, &approvers, false); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	want := []approver{
+		{GroupId: "group-owners", GroupName: "Owners", SourceId: "123456789012", SourceName: "production", SourceType: "Account"},
+		{GroupId: "group-platform", GroupName: "Platform", SourceId: "ou-workloads", SourceName: "Workloads", SourceType: "OU"},
+		{GroupId: "group-owners", GroupName: "Owners", SourceId: "ou-workloads", SourceName: "Workloads", SourceType: "OU"},
+		{GroupId: "group-security", GroupName: "Security", SourceId: "r-root", SourceName: "Root", SourceType: "OU"},
+	}
+
+	if len(approvers) != len(want) {
+		t.Fatalf("got approvers %+v, want %+v", approvers, want)
+	}
+
+	for i := range want {
+		if approvers[i] != want[i] {
+			t.Errorf("got %+v at %d, want %+v", approvers[i], i, want[i])
+		}
+	}
+
+	if data.Id.ValueString() != "123456789012" || len(data.GroupIds.Elements()) != 3 {
+		t.Errorf("got id %s and group ids %s", data.Id, data.GroupIds)
+	}
+}
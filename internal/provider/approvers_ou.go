@@ -72,10 +72,7 @@ func (r *ApproversOUResource) Schema(ctx context.Context, req resource.SchemaReq
 					stringplanmodifier.RequiresReplace(),
 				},
 				Validators: []validator.String{
-					stringvalidator.RegexMatches(
-						regexache.MustCompile(`[\s\S]*`),
-						"value must be a valid aws ou name.",
-					),
+					stringvalidator.LengthAtLeast(1),
 				},
 			},
 			"ou_id": schema.StringAttribute{
@@ -86,7 +83,7 @@ func (r *ApproversOUResource) Schema(ctx context.Context, req resource.SchemaReq
 				},
 				Validators: []validator.String{
 					stringvalidator.RegexMatches(
-						regexache.MustCompile(`^(r-[0-9a-z]{4,32})|(ou-[0-9a-z]{4,32}-[a-z0-9]{8,32})$`),
+						regexache.MustCompile(`^(r-[0-9a-z]{4,32}|ou-[0-9a-z]{4,32}-[a-z0-9]{8,32})$`),
 						"value must be a valid aws ou id.",
 					),
 				},
@@ -122,18 +119,18 @@ func (r *ApproversOUResource) Configure(ctx context.Context, req resource.Config
 		return
 	}
 
-	client, ok := req.ProviderData.(*awsteam.Client)
+	meta, ok := req.ProviderData.(*AWSTEAMClient)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *awsteam.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *provider.AWSTEAMClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = client
+	r.client = meta.Client
 }
 
 func (r *ApproversOUResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -170,7 +167,7 @@ func (r *ApproversOUResource) Create(ctx context.Context, req resource.CreateReq
 	out, err := r.client.CreateApprovers(ctx, in)
 
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create approvers ou, got error: %s", err))
+		resp.Diagnostics.Append(clientErrorDiagnostic("Unable to create approvers ou", err))
 		return
 	}
 
@@ -209,8 +206,14 @@ func (r *ApproversOUResource) Read(ctx context.Context, req resource.ReadRequest
 
 	out, err := r.client.GetApprovers(ctx, in)
 
+	if isNotFound(err) {
+		resp.Diagnostics.AddWarning("Read Error", "Received empty Approvers. Removing from state.")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read approvers ou policy, got error: %s", err))
+		resp.Diagnostics.Append(clientErrorDiagnostic("Unable to read approvers ou policy", err))
 		return
 	}
 
@@ -301,7 +304,7 @@ func (r *ApproversOUResource) Update(ctx context.Context, req resource.UpdateReq
 		out, err := r.client.UpdateApprovers(ctx, in)
 
 		if err != nil {
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update approvers ou, got error: %s", err))
+			resp.Diagnostics.Append(clientErrorDiagnostic("Unable to update approvers ou", err))
 			return
 		}
 
@@ -344,8 +347,8 @@ func (r *ApproversOUResource) Delete(ctx context.Context, req resource.DeleteReq
 
 	_, err := r.client.DeleteApprovers(ctx, in)
 
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete approvers ou, got error: %s", err))
+	if err != nil && !isAlreadyDeleted(err) {
+		resp.Diagnostics.Append(clientErrorDiagnostic("Unable to delete approvers ou", err))
 		return
 	}
 }
@@ -4,18 +4,18 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/brianvoe/gofakeit/v6"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
 func TestAccApproversOUResource_basic(t *testing.T) {
+	faker := testAccFaker(t)
 	resourceName := "awsteam_approvers_ou.test"
 	ouId := "ou-cxt3-2782ty5g"
-	ouName := gofakeit.BS()
-	approver1 := gofakeit.Email()
-	groupId1 := gofakeit.UUID()
-	approver2 := gofakeit.Email()
-	groupId2 := gofakeit.UUID()
+	ouName := faker.BS()
+	approver1 := faker.Email()
+	groupId1 := faker.UUID()
+	approver2 := faker.Email()
+	groupId2 := faker.UUID()
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
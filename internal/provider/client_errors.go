@@ -0,0 +1,42 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/awsteam-contrib/terraform-provider-awsteam/internal/sdk/awsteam"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+const unauthorizedDetail = "Check that the app client set in client_id is allowed the api/admin scope and that machine authentication is enabled on the AWS TEAM deployment."
+
+// clientErrorDiagnostic returns the diagnostic for an error returned by the
+// AWS TEAM client. msg describes the action that failed.
+func clientErrorDiagnostic(msg string, err error) diag.Diagnostic {
+	var unauthorized *awsteam.UnauthorizedError
+
+	if errors.As(err, &unauthorized) {
+		return diag.NewErrorDiagnostic(
+			"Unauthorized",
+			fmt.Sprintf("%s, the AWS TEAM API rejected the request as unauthorized: %s\n\n%s", msg, err, unauthorizedDetail),
+		)
+	}
+
+	return diag.NewErrorDiagnostic("Client Error", fmt.Sprintf("%s, got error: %s", msg, err))
+}
+
+// isNotFound reports whether err means the requested item does not exist.
+func isNotFound(err error) bool {
+	var notFound *awsteam.NotFoundError
+
+	return errors.As(err, &notFound)
+}
+
+// isAlreadyDeleted reports whether a delete failed because the item does not
+// exist. AppSync deletes are conditional on the item existing, so a missing
+// item is reported as a failed condition check.
+func isAlreadyDeleted(err error) bool {
+	var conflict *awsteam.ConflictError
+
+	return isNotFound(err) || errors.As(err, &conflict)
+}
@@ -0,0 +1,50 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/awsteam-contrib/terraform-provider-awsteam/internal/sdk/awsteam"
+)
+
+func TestClientErrorDiagnostic(t *testing.T) {
+	unauthorized := &awsteam.UnauthorizedError{ErrorDetails: awsteam.ErrorDetails{Operation: "GetEligibility", ErrorType: "Unauthorized"}}
+
+	d := clientErrorDiagnostic("Unable to read eligibility user policy", fmt.Errorf("wrapped: %w", unauthorized))
+
+	if d.Summary() != "Unauthorized" {
+		t.Errorf("got summary %q, want Unauthorized", d.Summary())
+	}
+
+	if !strings.Contains(d.Detail(), "api/admin") {
+		t.Errorf("detail does not explain the failure: %s", d.Detail())
+	}
+
+	d = clientErrorDiagnostic("Unable to read eligibility user policy", errors.New("boom"))
+
+	if d.Summary() != "Client Error" || d.Detail() != "Unable to read eligibility user policy, got error: boom" {
+		t.Errorf("got %q: %q", d.Summary(), d.Detail())
+	}
+}
+
+func TestIsAlreadyDeleted(t *testing.T) {
+	testCases := map[string]struct {
+		err  error
+		want bool
+	}{
+		"not found":   {err: &awsteam.NotFoundError{}, want: true},
+		"conflict":    {err: fmt.Errorf("wrapped: %w", &awsteam.ConflictError{}), want: true},
+		"throttled":   {err: &awsteam.ThrottlingError{}, want: false},
+		"other error": {err: errors.New("boom"), want: false},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if got := isAlreadyDeleted(tc.err); got != tc.want {
+				t.Errorf("got %t, want %t", got, tc.want)
+			}
+		})
+	}
+}
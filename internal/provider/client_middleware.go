@@ -0,0 +1,45 @@
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/awsteam-contrib/terraform-provider-awsteam/internal/sdk/awsteam"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// logOperationsMiddlewareID identifies the middleware logging the operations
+// sent to AWS TEAM.
+const logOperationsMiddlewareID = "LogOperations"
+
+// addLogOperationsMiddleware places a middleware first in the stack that logs
+// each operation with its outcome, including its retries. Variables are not
+// logged, as they may hold secrets such as the Slack token.
+func addLogOperationsMiddleware(stack *awsteam.MiddlewareStack) error {
+	return stack.Add(awsteam.MiddlewareFunc(logOperationsMiddlewareID, logOperation), awsteam.Before)
+}
+
+func logOperation(ctx context.Context, in *awsteam.OperationInput, next awsteam.Handler) (*awsteam.OperationOutput, error) {
+	start := time.Now()
+	out, err := next.Handle(ctx, in)
+
+	fields := map[string]interface{}{
+		"operation": in.Name,
+		"mutation":  in.IsMutation(),
+		"duration":  time.Since(start).String(),
+	}
+
+	if out != nil {
+		fields["status_code"] = out.StatusCode
+		fields["request_id"] = out.RequestID
+	}
+
+	if err != nil {
+		fields["error"] = err.Error()
+		tflog.Debug(ctx, "AWS TEAM operation failed", fields)
+	} else {
+		tflog.Debug(ctx, "AWS TEAM operation succeeded", fields)
+	}
+
+	return out, err
+}
@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/awsteam-contrib/terraform-provider-awsteam/internal/awsteamtest"
+	"github.com/awsteam-contrib/terraform-provider-awsteam/internal/sdk/awsteam"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+)
+
+func TestProviderConfigure_middleware(t *testing.T) {
+	ctx := context.Background()
+
+	server := awsteamtest.NewServer()
+	defer server.Close()
+
+	config := server.Config()
+
+	var stack, operations []string
+
+	p := &AWSTEAMProvider{
+		version: "test",
+		apiOptions: []func(*awsteam.MiddlewareStack) error{
+			func(s *awsteam.MiddlewareStack) error {
+				stack = s.List()
+
+				return s.Add(awsteam.MiddlewareFunc("Record", func(ctx context.Context, in *awsteam.OperationInput, next awsteam.Handler) (*awsteam.OperationOutput, error) {
+					operations = append(operations, in.Name)
+					return next.Handle(ctx, in)
+				}), awsteam.After)
+			},
+		},
+	}
+
+	resp := &provider.ConfigureResponse{}
+	p.Configure(ctx, provider.ConfigureRequest{Config: testProviderConfig(ctx, t, p, map[string]string{
+		"client_id":      config.ClientId,
+		"client_secret":  config.ClientSecret,
+		"graph_endpoint": config.GraphEndpoint,
+		"token_endpoint": config.TokenEndpoint,
+	})}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	if want := []string{logOperationsMiddlewareID, awsteam.RetryMiddlewareID}; !reflect.DeepEqual(stack, want) {
+		t.Errorf("got stack %v, want %v", stack, want)
+	}
+
+	client := resp.ResourceData.(*AWSTEAMClient).Client
+
+	if _, err := client.GetAccounts(ctx, &awsteam.GetAccountsInput{}); err != nil {
+		t.Fatalf("calling the fake server: %s", err)
+	}
+
+	if want := []string{"GetAccounts"}; !reflect.DeepEqual(operations, want) {
+		t.Errorf("got operations %v, want %v", operations, want)
+	}
+}
@@ -0,0 +1,379 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/smithy-go/ptr"
+	"github.com/awsteam-contrib/terraform-provider-awsteam/internal/sdk/awsteam"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	elevatedAccessDefaultTimeout = 15 * time.Minute
+	elevatedAccessPrivateKey     = "request_id"
+)
+
+// elevatedAccessPollInterval is the time between two reads of a request
+// waiting for its session to become active.
+var elevatedAccessPollInterval = 10 * time.Second
+
+// elevatedAccessPendingGrace is how long a request may stay pending before it
+// is taken to await approval. TEAM submits every request as pending and only
+// moves requests that need no approval on in the background.
+var elevatedAccessPendingGrace = time.Minute
+
+var _ ephemeral.EphemeralResource = &ElevatedAccessEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithClose = &ElevatedAccessEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithConfigure = &ElevatedAccessEphemeralResource{}
+
+func NewElevatedAccessEphemeralResource() ephemeral.EphemeralResource {
+	return &ElevatedAccessEphemeralResource{}
+}
+
+type ElevatedAccessEphemeralResource struct {
+	client *awsteam.Client
+	lookup *eligibilityLookup
+}
+
+type ElevatedAccessModel struct {
+	Id               types.String `tfsdk:"id"`
+	UserName         types.String `tfsdk:"user_name"`
+	Email            types.String `tfsdk:"email"`
+	AccountId        types.String `tfsdk:"account_id"`
+	AccountName      types.String `tfsdk:"account_name"`
+	PermissionArn    types.String `tfsdk:"permission_arn"`
+	PermissionName   types.String `tfsdk:"permission_name"`
+	Duration         types.Int64  `tfsdk:"duration"`
+	Justification    types.String `tfsdk:"justification"`
+	TicketNo         types.String `tfsdk:"ticket_no"`
+	Timeout          types.Int64  `tfsdk:"timeout"`
+	ApprovalRequired types.Bool   `tfsdk:"approval_required"`
+	Status           types.String `tfsdk:"status"`
+	StartTime        types.String `tfsdk:"start_time"`
+	EndTime          types.String `tfsdk:"end_time"`
+}
+
+func (r *ElevatedAccessEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_elevated_access"
+}
+
+func (r *ElevatedAccessEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Requests elevated access through an AWS TEAM deployment for the duration of a terraform run. Requires terraform 1.10 or later.\n\n" +
+			"Opening the ephemeral resource submits a request starting immediately. " +
+			"TEAM submits every request as `pending` and approves requests that need no approval in the background. " +
+			"A request that is still `pending` a minute after submission awaits approval and is returned. " +
+			"Once TEAM has approved or scheduled the request, terraform waits until the session has started, for at most `timeout` seconds in total.\n\n" +
+			"Closing the ephemeral resource at the end of the run cancels the request when it is still pending and revokes the session otherwise. Nothing is written to state.\n\n" +
+			"~> **Warning:** Terraform opens ephemeral resources during `terraform plan` as well as `terraform apply`. " +
+			"Every plan with a fully known configuration therefore submits a real access request and ends it again, which notifies approvers and leaves entries in the TEAM audit log. " +
+			"No request is submitted while any argument is unknown, so make an argument depend on a value only known after apply, such as an attribute of a `terraform_data` resource, to request access during apply only.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The id of the request.",
+				Computed:            true,
+			},
+			"user_name": schema.StringAttribute{
+				MarkdownDescription: "The IAM Identity Center user name of the user access is requested for.",
+				Required:            true,
+			},
+			"email": schema.StringAttribute{
+				MarkdownDescription: "The email address of the user access is requested for. TEAM sends notifications about the request to this address.",
+				Optional:            true,
+			},
+			"account_id": schema.StringAttribute{
+				MarkdownDescription: "The AWS account id access is requested for.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(
+						regexache.MustCompile(`^\d{12}$`),
+						"value must be a valid aws account id.",
+					),
+				},
+			},
+			"account_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the AWS account access is requested for. When omitted, the name is looked up in the accounts known to AWS TEAM.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"permission_arn": schema.StringAttribute{
+				MarkdownDescription: "The ARN of the requested permission set.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(
+						regexache.MustCompile(`^arn:(aws|aws-us-gov|aws-cn|aws-iso|aws-iso-b):sso:::permissionSet/(sso)?ins-[a-zA-Z0-9-.]{16}/ps-[a-zA-Z0-9-./]{16}$`),
+						"value must be a valid AWS permissionSet ARN.",
+					),
+				},
+			},
+			"permission_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the requested permission set. When omitted, the name is looked up in the permission sets known to AWS TEAM.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"duration": schema.Int64Attribute{
+				MarkdownDescription: "The requested duration of the session in hours.",
+				Required:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"justification": schema.StringAttribute{
+				MarkdownDescription: "Why access is needed. Required when comments are mandatory in the TEAM settings.",
+				Optional:            true,
+			},
+			"ticket_no": schema.StringAttribute{
+				MarkdownDescription: "The Change Management system ticket system number. Required when ticket numbers are mandatory in the TEAM settings.",
+				Optional:            true,
+			},
+			"timeout": schema.Int64Attribute{
+				MarkdownDescription: "The maximum number of seconds to wait for the session of a request that needs no approval to become active. The request is cancelled or revoked when the session is not active in time. Defaults to `900`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"approval_required": schema.BoolAttribute{
+				MarkdownDescription: "Whether the request awaits approval, because it was still `pending` a minute after submission.",
+				Computed:            true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "The status of the request when it was returned, `in progress` once the session is active and `pending` while it awaits approval.",
+				Computed:            true,
+			},
+			"start_time": schema.StringAttribute{
+				MarkdownDescription: "When the session starts.",
+				Computed:            true,
+			},
+			"end_time": schema.StringAttribute{
+				MarkdownDescription: "When the session ends. Only set once the session is active.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *ElevatedAccessEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	meta, ok := req.ProviderData.(*AWSTEAMClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *provider.AWSTEAMClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = meta.Client
+	r.lookup = meta.eligibilityLookup()
+}
+
+func (r *ElevatedAccessEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data ElevatedAccessModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Terraform opens ephemeral resources during plan too. A request is only
+	// submitted once the configuration is known, so a configuration depending
+	// on values known after apply does not request access during plan.
+	if !req.Config.Raw.IsFullyKnown() {
+		tflog.Debug(ctx, "configuration is not known yet, not submitting an access request")
+
+		data.unknown()
+
+		resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+		return
+	}
+
+	in, diags := expandAccessRequest(ctx, r.lookup, AccessRequestModel{
+		UserName:       data.UserName,
+		Email:          data.Email,
+		AccountId:      data.AccountId,
+		AccountName:    data.AccountName,
+		PermissionArn:  data.PermissionArn,
+		PermissionName: data.PermissionName,
+		Duration:       data.Duration,
+		StartTime:      types.StringNull(),
+		Justification:  data.Justification,
+		TicketNo:       data.TicketNo,
+	})
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := r.client.CreateRequest(ctx, in)
+
+	if err != nil {
+		resp.Diagnostics.Append(clientErrorDiagnostic("Unable to create access request", err))
+		return
+	}
+
+	if out == nil || out.Request == nil || out.Request.Id == nil {
+		resp.Diagnostics.AddError("Open Error", "Received empty Request.")
+		return
+	}
+
+	request := out.Request
+	id := *request.Id
+
+	tflog.Debug(ctx, "submitted access request", map[string]interface{}{"id": id})
+
+	timeout := elevatedAccessDefaultTimeout
+
+	if !data.Timeout.IsNull() {
+		timeout = time.Duration(data.Timeout.ValueInt64()) * time.Second
+	}
+
+	waitCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	request, err = waitForAccessRequest(waitCtx, func(ctx context.Context) (*awsteam.Request, error) {
+		out, err := r.client.GetRequest(ctx, &awsteam.GetRequestInput{Id: &id})
+
+		if err != nil {
+			return nil, err
+		}
+
+		return out.Request, nil
+	}, elevatedAccessPollInterval, elevatedAccessPendingGrace)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Elevated Access Not Active", fmt.Sprintf("The session of access request %s did not become active: %s", id, err))
+
+		// Without a result Close is never called, so the request is ended here.
+		resp.Diagnostics.Append(endAccessRequest(ctx, r.client, id)...)
+		return
+	}
+
+	privateId, err := json.Marshal(id)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Open Error", fmt.Sprintf("Unable to encode the access request id: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, elevatedAccessPrivateKey, privateId)...)
+
+	data.flatten(request)
+
+	tflog.Trace(ctx, "opened elevated access ephemeral resource")
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+func (r *ElevatedAccessEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	privateId, diags := req.Private.GetKey(ctx, elevatedAccessPrivateKey)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || privateId == nil {
+		return
+	}
+
+	var id string
+
+	if err := json.Unmarshal(privateId, &id); err != nil {
+		resp.Diagnostics.AddError("Close Error", fmt.Sprintf("Unable to decode the access request id: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(endAccessRequest(ctx, r.client, id)...)
+
+	tflog.Trace(ctx, "closed elevated access ephemeral resource")
+}
+
+// waitForAccessRequest reads the request with get every interval while it is
+// pending, approved or scheduled, and returns it once its session is active. A
+// request still pending after grace awaits approval and is returned as is. It
+// fails when the request ends without becoming active or ctx is done first.
+func waitForAccessRequest(ctx context.Context, get func(context.Context) (*awsteam.Request, error), interval, grace time.Duration) (*awsteam.Request, error) {
+	status := ""
+	pendingUntil := time.Now().Add(grace)
+
+	for {
+		request, err := get(ctx)
+
+		if err != nil && ctx.Err() == nil {
+			return nil, err
+		}
+
+		if err == nil {
+			status = ptr.ToString(request.Status)
+
+			switch status {
+			case RequestStatusInProgress:
+				return request, nil
+			case RequestStatusPending:
+				if !time.Now().Before(pendingUntil) {
+					return request, nil
+				}
+			case RequestStatusApproved, RequestStatusScheduled:
+			default:
+				return nil, fmt.Errorf("the request has status %q", status)
+			}
+		}
+
+		timer := time.NewTimer(interval)
+
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, fmt.Errorf("timed out with status %q", status)
+		case <-timer.C:
+		}
+	}
+}
+
+// unknown sets the computed attributes of d to unknown values.
+func (d *ElevatedAccessModel) unknown() {
+	d.Id = types.StringUnknown()
+	d.ApprovalRequired = types.BoolUnknown()
+	d.Status = types.StringUnknown()
+	d.StartTime = types.StringUnknown()
+	d.EndTime = types.StringUnknown()
+
+	if d.AccountName.IsNull() {
+		d.AccountName = types.StringUnknown()
+	}
+
+	if d.PermissionName.IsNull() {
+		d.PermissionName = types.StringUnknown()
+	}
+}
+
+func (d *ElevatedAccessModel) flatten(out *awsteam.Request) {
+	d.Id = types.StringPointerValue(out.Id)
+	d.AccountName = types.StringPointerValue(out.AccountName)
+	d.PermissionName = types.StringPointerValue(out.Role)
+	d.ApprovalRequired = types.BoolValue(ptr.ToString(out.Status) == RequestStatusPending)
+	d.Status = types.StringPointerValue(out.Status)
+	d.StartTime = types.StringPointerValue(out.StartTime)
+	d.EndTime = types.StringPointerValue(out.EndTime)
+}
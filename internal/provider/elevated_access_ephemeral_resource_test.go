@@ -0,0 +1,352 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aws/smithy-go/ptr"
+	"github.com/awsteam-contrib/terraform-provider-awsteam/internal/sdk/awsteam"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestWaitForAccessRequest(t *testing.T) {
+	tests := []struct {
+		name       string
+		statuses   []string
+		grace      time.Duration
+		err        error
+		wantErr    string
+		wantGets   int
+		wantStatus string
+	}{
+		{
+			name:       "active",
+			statuses:   []string{RequestStatusInProgress},
+			wantGets:   1,
+			wantStatus: RequestStatusInProgress,
+		},
+		{
+			name:       "becomes active",
+			statuses:   []string{RequestStatusApproved, RequestStatusScheduled, RequestStatusInProgress},
+			wantGets:   3,
+			wantStatus: RequestStatusInProgress,
+		},
+		{
+			name:       "approved in the background",
+			statuses:   []string{RequestStatusPending, RequestStatusPending, RequestStatusApproved, RequestStatusInProgress},
+			grace:      time.Minute,
+			wantGets:   4,
+			wantStatus: RequestStatusInProgress,
+		},
+		{
+			name:       "awaits approval",
+			statuses:   []string{RequestStatusPending},
+			wantGets:   1,
+			wantStatus: RequestStatusPending,
+		},
+		{
+			name:     "rejected",
+			statuses: []string{RequestStatusApproved, RequestStatusRejected},
+			wantErr:  `the request has status "rejected"`,
+			wantGets: 2,
+		},
+		{
+			name:     "error",
+			statuses: []string{RequestStatusApproved, RequestStatusError},
+			wantErr:  `the request has status "error"`,
+			wantGets: 2,
+		},
+		{
+			name:     "read error",
+			err:      errors.New("boom"),
+			wantErr:  "boom",
+			wantGets: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gets := 0
+
+			request, err := waitForAccessRequest(context.Background(), func(ctx context.Context) (*awsteam.Request, error) {
+				gets++
+
+				if tt.err != nil {
+					return nil, tt.err
+				}
+
+				return &awsteam.Request{Status: ptr.String(tt.statuses[gets-1])}, nil
+			}, time.Millisecond, tt.grace)
+
+			if gets != tt.wantGets {
+				t.Errorf("got %d reads, want %d", gets, tt.wantGets)
+			}
+
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Fatalf("got error %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got := ptr.ToString(request.Status); got != tt.wantStatus {
+				t.Errorf("got status %q, want %q", got, tt.wantStatus)
+			}
+		})
+	}
+}
+
+func TestWaitForAccessRequest_timeout(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	_, err := waitForAccessRequest(ctx, func(ctx context.Context) (*awsteam.Request, error) {
+		return &awsteam.Request{Status: ptr.String(RequestStatusScheduled)}, nil
+	}, time.Millisecond, time.Minute)
+
+	if err == nil || !strings.Contains(err.Error(), `timed out with status "scheduled"`) {
+		t.Fatalf("got error %v, want a timeout", err)
+	}
+}
+
+func TestElevatedAccessModel_flatten(t *testing.T) {
+	var data ElevatedAccessModel
+
+	data.flatten(&awsteam.Request{
+		Id:          ptr.String("0f3c1d5e-4c52-4d0e-9c7a-5d0b6b1a2f3e"),
+		AccountName: ptr.String("production"),
+		Role:        ptr.String("Admin"),
+		Status:      ptr.String(RequestStatusInProgress),
+		StartTime:   ptr.String("2024-05-01T10:00:00Z"),
+		EndTime:     ptr.String("2024-05-01T12:00:00Z"),
+	})
+
+	if data.Id.ValueString() != "0f3c1d5e-4c52-4d0e-9c7a-5d0b6b1a2f3e" {
+		t.Errorf("id = %s", data.Id)
+	}
+
+	if data.AccountName.ValueString() != "production" || data.PermissionName.ValueString() != "Admin" {
+		t.Errorf("account_name = %s, permission_name = %s", data.AccountName, data.PermissionName)
+	}
+
+	if data.ApprovalRequired.ValueBool() || data.ApprovalRequired.IsNull() {
+		t.Errorf("approval_required = %s, want false", data.ApprovalRequired)
+	}
+
+	if data.Status.ValueString() != RequestStatusInProgress || data.EndTime.ValueString() != "2024-05-01T12:00:00Z" {
+		t.Errorf("status = %s, end_time = %s", data.Status, data.EndTime)
+	}
+}
+
+func TestElevatedAccessEphemeralResource_openUnknown(t *testing.T) {
+	ctx := context.Background()
+
+	// Without a client, any request submitted would panic.
+	r := &ElevatedAccessEphemeralResource{}
+
+	schemaResp := &ephemeral.SchemaResponse{}
+	r.Schema(ctx, ephemeral.SchemaRequest{}, schemaResp)
+
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	attrs := map[string]tftypes.Value{}
+
+	for name, attrType := range objectType.AttributeTypes {
+		attrs[name] = tftypes.NewValue(attrType, nil)
+	}
+
+	attrs["user_name"] = tftypes.NewValue(tftypes.String, "jane.doe@contoso.com")
+	attrs["account_id"] = tftypes.NewValue(tftypes.String, tftypes.UnknownValue)
+	attrs["permission_arn"] = tftypes.NewValue(tftypes.String, "arn:aws:sso:::permissionSet/ssoins-4334d1f197f50907/ps-f5ge203d3d2428d3")
+	attrs["duration"] = tftypes.NewValue(tftypes.Number, 1)
+
+	req := ephemeral.OpenRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, attrs)}}
+	resp := &ephemeral.OpenResponse{Result: tfsdk.EphemeralResultData{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)}}
+
+	r.Open(ctx, req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	var data ElevatedAccessModel
+
+	resp.Diagnostics.Append(resp.Result.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	if !data.Id.IsUnknown() || !data.Status.IsUnknown() || !data.AccountName.IsUnknown() {
+		t.Errorf("got id %s, status %s and account_name %s, want unknown values", data.Id, data.Status, data.AccountName)
+	}
+}
+
+func TestElevatedAccessModel_flattenPending(t *testing.T) {
+	var data ElevatedAccessModel
+
+	data.flatten(&awsteam.Request{
+		Id:     ptr.String("0f3c1d5e-4c52-4d0e-9c7a-5d0b6b1a2f3e"),
+		Status: ptr.String(RequestStatusPending),
+	})
+
+	if !data.ApprovalRequired.ValueBool() {
+		t.Errorf("approval_required = %s, want true", data.ApprovalRequired)
+	}
+
+	if !data.EndTime.IsNull() {
+		t.Errorf("end_time = %s, want null", data.EndTime)
+	}
+}
+
+func TestElevatedAccessEphemeralResource_openApprovedInBackground(t *testing.T) {
+	ctx := context.Background()
+
+	pollInterval, pendingGrace := elevatedAccessPollInterval, elevatedAccessPendingGrace
+	elevatedAccessPollInterval, elevatedAccessPendingGrace = time.Millisecond, time.Minute
+
+	defer func() {
+		elevatedAccessPollInterval, elevatedAccessPendingGrace = pollInterval, pendingGrace
+	}()
+
+	// TEAM returns the request it created as pending and approves it in the
+	// background before the session starts.
+	statuses := []string{RequestStatusPending, RequestStatusApproved, RequestStatusInProgress}
+
+	var mu sync.Mutex
+	gets := 0
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/oauth2/token", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"access_token":"test-token","expires_in":3600,"token_type":"Bearer"}`))
+	})
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Query string `json:"query"`
+		}
+
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		request := map[string]interface{}{
+			"id":          "0f3c1d5e-4c52-4d0e-9c7a-5d0b6b1a2f3e",
+			"accountName": "production",
+			"role":        "Admin",
+			"startTime":   "2024-05-01T10:00:00Z",
+		}
+		field := "createRequests"
+
+		mu.Lock()
+
+		switch {
+		case strings.Contains(body.Query, "createRequests"):
+			request["status"] = RequestStatusPending
+		case strings.Contains(body.Query, "getRequests"):
+			field = "getRequests"
+			request["status"] = statuses[min(gets, len(statuses)-1)]
+			gets++
+		default:
+			mu.Unlock()
+			http.Error(w, "unexpected query", http.StatusBadRequest)
+			return
+		}
+
+		if request["status"] == RequestStatusInProgress {
+			request["endTime"] = "2024-05-01T11:00:00Z"
+		}
+
+		mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"data": map[string]interface{}{field: request}})
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	config := &awsteam.Config{
+		ClientId:      "test-client",
+		ClientSecret:  "test-secret",
+		GraphEndpoint: server.URL + "/graphql",
+		TokenEndpoint: server.URL + "/oauth2/token",
+	}
+
+	if err := config.Build(ctx); err != nil {
+		t.Fatalf("requesting token: %s", err)
+	}
+
+	client, err := config.NewClient(ctx)
+
+	if err != nil {
+		t.Fatalf("creating client: %s", err)
+	}
+
+	r := &ElevatedAccessEphemeralResource{client: client, lookup: newEligibilityLookup(client)}
+
+	schemaResp := &ephemeral.SchemaResponse{}
+	r.Schema(ctx, ephemeral.SchemaRequest{}, schemaResp)
+
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	attrs := map[string]tftypes.Value{}
+
+	for name, attrType := range objectType.AttributeTypes {
+		attrs[name] = tftypes.NewValue(attrType, nil)
+	}
+
+	attrs["user_name"] = tftypes.NewValue(tftypes.String, "jane.doe@contoso.com")
+	attrs["account_id"] = tftypes.NewValue(tftypes.String, "123456789012")
+	attrs["account_name"] = tftypes.NewValue(tftypes.String, "production")
+	attrs["permission_arn"] = tftypes.NewValue(tftypes.String, testAdminArn)
+	attrs["permission_name"] = tftypes.NewValue(tftypes.String, "Admin")
+	attrs["duration"] = tftypes.NewValue(tftypes.Number, 1)
+
+	req := ephemeral.OpenRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, attrs)}}
+	resp := &ephemeral.OpenResponse{Result: tfsdk.EphemeralResultData{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)}}
+
+	// The type of the private state is internal to the framework, which sets it
+	// before calling Open. Its zero value is ready to use.
+	private := reflect.ValueOf(&resp.Private).Elem()
+	private.Set(reflect.New(private.Type().Elem()))
+
+	r.Open(ctx, req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	var data ElevatedAccessModel
+
+	resp.Diagnostics.Append(resp.Result.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	if data.Status.ValueString() != RequestStatusInProgress || data.EndTime.ValueString() != "2024-05-01T11:00:00Z" {
+		t.Errorf("got status %s and end_time %s, want the active session", data.Status, data.EndTime)
+	}
+
+	if data.ApprovalRequired.ValueBool() {
+		t.Error("approval_required = true, want false")
+	}
+
+	if gets != len(statuses) {
+		t.Errorf("got %d reads, want %d", gets, len(statuses))
+	}
+}
@@ -0,0 +1,463 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/smithy-go/ptr"
+	"github.com/awsteam-contrib/terraform-provider-awsteam/internal/sdk/awsteam"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	eligibilityAttrTypes = map[string]attr.Type{
+		"id":                types.StringType,
+		"name":              types.StringType,
+		"type":              types.StringType,
+		"accounts":          types.SetType{ElemType: types.ObjectType{AttrTypes: eligibilityAccountAttrTypes}},
+		"ous":               types.SetType{ElemType: types.ObjectType{AttrTypes: eligibilityOUAttrTypes}},
+		"permissions":       types.SetType{ElemType: types.ObjectType{AttrTypes: eligibilityPermissionAttrTypes}},
+		"ticket_no":         types.StringType,
+		"approval_required": types.BoolType,
+		"duration":          types.Int64Type,
+		"modified_by":       types.StringType,
+		"created_at":        types.StringType,
+		"updated_at":        types.StringType,
+	}
+)
+
+var _ datasource.DataSource = &EligibilitiesDataSource{}
+
+func NewEligibilitiesDataSource() datasource.DataSource {
+	return &EligibilitiesDataSource{}
+}
+
+type EligibilitiesDataSource struct {
+	client *awsteam.Client
+}
+
+type EligibilitiesModel struct {
+	Id            types.String `tfsdk:"id"`
+	PrincipalType types.String `tfsdk:"principal_type"`
+	PrincipalId   types.String `tfsdk:"principal_id"`
+	AccountId     types.String `tfsdk:"account_id"`
+	OUId          types.String `tfsdk:"ou_id"`
+	PermissionArn types.String `tfsdk:"permission_arn"`
+	Eligibilities types.List   `tfsdk:"eligibilities"`
+}
+
+func (d *EligibilitiesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_eligibilities"
+}
+
+func eligibilityAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "The id of the IAM Identity Center user or group the eligibility policy applies to.",
+			Computed:            true,
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "The name of the IAM Identity Center user or group the eligibility policy applies to.",
+			Computed:            true,
+		},
+		"type": schema.StringAttribute{
+			MarkdownDescription: "The type of principal the eligibility policy applies to, either `User` or `Group`.",
+			Computed:            true,
+		},
+		"accounts": schema.SetNestedAttribute{
+			MarkdownDescription: "The AWS accounts the eligibility policy applies to.",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"account_id": schema.StringAttribute{
+						MarkdownDescription: "The AWS account id.",
+						Computed:            true,
+					},
+					"account_name": schema.StringAttribute{
+						MarkdownDescription: "Name of the AWS account.",
+						Computed:            true,
+					},
+				},
+			},
+		},
+		"ous": schema.SetNestedAttribute{
+			MarkdownDescription: "The AWS OUs the eligibility policy applies to.",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"ou_id": schema.StringAttribute{
+						MarkdownDescription: "Id of the OU.",
+						Computed:            true,
+					},
+					"ou_name": schema.StringAttribute{
+						MarkdownDescription: "Name of the OU.",
+						Computed:            true,
+					},
+				},
+			},
+		},
+		"permissions": schema.SetNestedAttribute{
+			MarkdownDescription: "The permission sets of the eligibility policy.",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"permission_arn": schema.StringAttribute{
+						MarkdownDescription: "The ARN of the permission set.",
+						Computed:            true,
+					},
+					"permission_name": schema.StringAttribute{
+						MarkdownDescription: "Name of the permission set.",
+						Computed:            true,
+					},
+				},
+			},
+		},
+		"ticket_no": schema.StringAttribute{
+			MarkdownDescription: "The Change Management system ticket system number.",
+			Computed:            true,
+		},
+		"approval_required": schema.BoolAttribute{
+			MarkdownDescription: "Determines if approval is required for elevated access",
+			Computed:            true,
+		},
+		"duration": schema.Int64Attribute{
+			MarkdownDescription: "The maximum elevated access request duration in hours.",
+			Computed:            true,
+		},
+		"modified_by": schema.StringAttribute{
+			MarkdownDescription: "The user to last modify the item",
+			Computed:            true,
+		},
+		"created_at": schema.StringAttribute{
+			MarkdownDescription: "The date and time that the item was created",
+			Computed:            true,
+		},
+		"updated_at": schema.StringAttribute{
+			MarkdownDescription: "The date and time of the last time the item was updated",
+			Computed:            true,
+		},
+	}
+}
+
+func (d *EligibilitiesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Provides a data source for the eligibility policies of an AWS TEAM deployment. " +
+			"All filters are optional and only eligibility policies matching every configured filter are returned.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Eligibilities Identifier. This is a static value of `eligibilities`.",
+				Computed:            true,
+			},
+			"principal_type": schema.StringAttribute{
+				MarkdownDescription: "Only return eligibility policies for this type of principal, either `User` or `Group`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(EligibilityUserType, EligibilityGroupType),
+				},
+			},
+			"principal_id": schema.StringAttribute{
+				MarkdownDescription: "Only return the eligibility policy of the IAM Identity Center user or group with this id.",
+				Optional:            true,
+			},
+			"account_id": schema.StringAttribute{
+				MarkdownDescription: "Only return eligibility policies that grant this AWS account, either by listing it in `accounts` or by listing one of the OUs containing it in `ous`.",
+				Optional:            true,
+			},
+			"ou_id": schema.StringAttribute{
+				MarkdownDescription: "Only return eligibility policies that list this OU id in `ous`.",
+				Optional:            true,
+			},
+			"permission_arn": schema.StringAttribute{
+				MarkdownDescription: "Only return eligibility policies that grant the permission set with this ARN.",
+				Optional:            true,
+			},
+			"eligibilities": schema.ListNestedAttribute{
+				MarkdownDescription: "A list of the matching eligibility policies in the order returned by AWS TEAM.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: eligibilityAttributes(),
+				},
+			},
+		},
+	}
+}
+
+func (d *EligibilitiesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	meta, ok := req.ProviderData.(*AWSTEAMClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.AWSTEAMClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = meta.Client
+}
+
+func (d *EligibilitiesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data EligibilitiesModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var accountOUs map[string]bool
+
+	if !data.AccountId.IsNull() {
+		ancestors, diags := readAccountAncestors(ctx, d.client, data.AccountId.ValueString())
+
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		accountOUs = map[string]bool{}
+
+		for _, ou := range ancestors {
+			accountOUs[ptr.ToString(ou.Id)] = true
+		}
+	}
+
+	eligibilities, err := listEligibilities(ctx, d.client)
+
+	if err != nil {
+		resp.Diagnostics.Append(clientErrorDiagnostic("Unable to read eligibilities", err))
+		return
+	}
+
+	resp.Diagnostics.Append(data.flatten(eligibilities, accountOUs)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "read eligibilities data source")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// flatten sets Eligibilities to the eligibilities matching the filters of d.
+// accountOUs holds the ids of the OUs containing the account of the
+// account_id filter, up to the root.
+func (d *EligibilitiesModel) flatten(eligibilities []*awsteam.Eligibility, accountOUs map[string]bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	elems := []attr.Value{}
+
+	for _, eligibility := range eligibilities {
+		if eligibility == nil || !d.matches(eligibility, accountOUs) {
+			continue
+		}
+
+		obj, objDiags := flattenEligibility(eligibility)
+		diags.Append(objDiags...)
+
+		elems = append(elems, obj)
+	}
+
+	if diags.HasError() {
+		return diags
+	}
+
+	list, listDiags := types.ListValue(types.ObjectType{AttrTypes: eligibilityAttrTypes}, elems)
+	diags.Append(listDiags...)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	d.Id = types.StringValue("eligibilities")
+	d.Eligibilities = list
+
+	return diags
+}
+
+// listEligibilities returns the eligibility policies of all pages.
+func listEligibilities(ctx context.Context, client *awsteam.Client) ([]*awsteam.Eligibility, error) {
+	var eligibilities []*awsteam.Eligibility
+
+	paginator := awsteam.NewListEligibilitiesPaginator(client, &awsteam.ListEligibilitiesInput{})
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		eligibilities = append(eligibilities, page.Eligibilities...)
+	}
+
+	return eligibilities, nil
+}
+
+// matches returns whether eligibility passes all configured filters. The
+// account filter also matches eligibilities listing one of accountOUs.
+func (d *EligibilitiesModel) matches(eligibility *awsteam.Eligibility, accountOUs map[string]bool) bool {
+	if !d.PrincipalType.IsNull() && ptr.ToString(eligibility.Type) != d.PrincipalType.ValueString() {
+		return false
+	}
+
+	if !d.PrincipalId.IsNull() && ptr.ToString(eligibility.Id) != d.PrincipalId.ValueString() {
+		return false
+	}
+
+	if !d.AccountId.IsNull() {
+		found := false
+
+		for _, account := range eligibility.Accounts {
+			if account != nil && ptr.ToString(account.Id) == d.AccountId.ValueString() {
+				found = true
+				break
+			}
+		}
+
+		for _, ou := range eligibility.OUs {
+			if ou != nil && accountOUs[ptr.ToString(ou.Id)] {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return false
+		}
+	}
+
+	if !d.OUId.IsNull() {
+		found := false
+
+		for _, ou := range eligibility.OUs {
+			if ou != nil && ptr.ToString(ou.Id) == d.OUId.ValueString() {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return false
+		}
+	}
+
+	if !d.PermissionArn.IsNull() {
+		found := false
+
+		for _, permission := range eligibility.Permissions {
+			if permission != nil && ptr.ToString(permission.Id) == d.PermissionArn.ValueString() {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+// flattenEligibility returns eligibility as an object of eligibilityAttrTypes.
+func flattenEligibility(eligibility *awsteam.Eligibility) (types.Object, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	accounts := []attr.Value{}
+
+	for _, account := range eligibility.Accounts {
+		if account == nil {
+			continue
+		}
+
+		obj, objDiags := types.ObjectValue(eligibilityAccountAttrTypes, map[string]attr.Value{
+			"account_id":   types.StringPointerValue(account.Id),
+			"account_name": types.StringPointerValue(account.Name),
+		})
+		diags.Append(objDiags...)
+
+		accounts = append(accounts, obj)
+	}
+
+	ous := []attr.Value{}
+
+	for _, ou := range eligibility.OUs {
+		if ou == nil {
+			continue
+		}
+
+		obj, objDiags := types.ObjectValue(eligibilityOUAttrTypes, map[string]attr.Value{
+			"ou_id":   types.StringPointerValue(ou.Id),
+			"ou_name": types.StringPointerValue(ou.Name),
+		})
+		diags.Append(objDiags...)
+
+		ous = append(ous, obj)
+	}
+
+	permissions := []attr.Value{}
+
+	for _, permission := range eligibility.Permissions {
+		if permission == nil {
+			continue
+		}
+
+		obj, objDiags := types.ObjectValue(eligibilityPermissionAttrTypes, map[string]attr.Value{
+			"permission_arn":  types.StringPointerValue(permission.Id),
+			"permission_name": types.StringPointerValue(permission.Name),
+		})
+		diags.Append(objDiags...)
+
+		permissions = append(permissions, obj)
+	}
+
+	if diags.HasError() {
+		return types.ObjectNull(eligibilityAttrTypes), diags
+	}
+
+	accountSet, setDiags := types.SetValue(types.ObjectType{AttrTypes: eligibilityAccountAttrTypes}, accounts)
+	diags.Append(setDiags...)
+
+	ouSet, setDiags := types.SetValue(types.ObjectType{AttrTypes: eligibilityOUAttrTypes}, ous)
+	diags.Append(setDiags...)
+
+	permissionSet, setDiags := types.SetValue(types.ObjectType{AttrTypes: eligibilityPermissionAttrTypes}, permissions)
+	diags.Append(setDiags...)
+
+	if diags.HasError() {
+		return types.ObjectNull(eligibilityAttrTypes), diags
+	}
+
+	obj, objDiags := types.ObjectValue(eligibilityAttrTypes, map[string]attr.Value{
+		"id":                types.StringPointerValue(eligibility.Id),
+		"name":              types.StringPointerValue(eligibility.Name),
+		"type":              types.StringPointerValue(eligibility.Type),
+		"accounts":          accountSet,
+		"ous":               ouSet,
+		"permissions":       permissionSet,
+		"ticket_no":         types.StringPointerValue(eligibility.TicketNo),
+		"approval_required": types.BoolPointerValue(eligibility.ApprovalRequired),
+		"duration":          types.Int64PointerValue(eligibility.Duration),
+		"modified_by":       types.StringPointerValue(eligibility.ModifiedBy),
+		"created_at":        types.StringPointerValue(eligibility.CreatedAt),
+		"updated_at":        types.StringPointerValue(eligibility.UpdatedAt),
+	})
+	diags.Append(objDiags...)
+
+	return obj, diags
+}
@@ -0,0 +1,175 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/smithy-go/ptr"
+	"github.com/awsteam-contrib/terraform-provider-awsteam/internal/sdk/awsteam"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccEligibilitiesDataSource_basic(t *testing.T) {
+	dataSourceName := "data.awsteam_eligibilities.test"
+	group := gofakeit.Email()
+	groupId := gofakeit.UUID()
+	duration := fmt.Sprint(gofakeit.Number(1, 10))
+	ticketNo := gofakeit.BS()
+	accountId := gofakeit.DigitN(12)
+	accountName := gofakeit.BS()
+	ouId := "ou-cxt3-2782ty5g" // hard coded fake ou id
+	ouName := gofakeit.BS()
+	permissionArn := "arn:aws:sso:::permissionSet/ssoins-4334d1f197f50907/ps-f5ge203d3d2428d3" // hard coded fake arn
+	permissionName := "elevated"
+
+	// The account filter looks up the OUs containing the account, so it has
+	// to be part of the organization.
+	if testAccServer != nil {
+		testAccServer.SetOUs(&awsteam.OU{
+			Id:       ptr.String("r-cxt3"),
+			Children: []awsteam.OU{{Id: ptr.String(ouId), Name: ptr.String(ouName)}},
+		})
+		testAccServer.SetParent(accountId, ouId)
+	} else {
+		// This environment variable should be set to the id of an account of the organization.
+		expectedAccountsIdVar := "AWSTEAM_TESTS_EXPECTED_ACCOUNT_ID"
+		accountId = os.Getenv(expectedAccountsIdVar)
+		if accountId == "" {
+			t.Skipf("Skipping Eligibilities Tests, Environment variable %s is not set.", expectedAccountsIdVar)
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEligibilityGroupResourceConfig(group, groupId, true, duration, ticketNo, accountId, accountName, ouId, ouName, permissionArn, permissionName) +
+					testAccEligibilitiesDataSourceConfig(accountId),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "id", "eligibilities"),
+					resource.TestCheckResourceAttr(dataSourceName, "eligibilities.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "eligibilities.0.id", groupId),
+					resource.TestCheckResourceAttr(dataSourceName, "eligibilities.0.name", group),
+					resource.TestCheckResourceAttr(dataSourceName, "eligibilities.0.type", EligibilityGroupType),
+					resource.TestCheckResourceAttr(dataSourceName, "eligibilities.0.duration", duration),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "eligibilities.0.accounts.*",
+						map[string]string{
+							"account_id":   accountId,
+							"account_name": accountName,
+						}),
+				),
+			},
+		},
+	})
+}
+
+func testAccEligibilitiesDataSourceConfig(accountId string) string {
+	return fmt.Sprintf(`
+data "awsteam_eligibilities" "test" {
+  principal_id = awsteam_eligibility_group.test.id
+  account_id   = %[1]q
+}`, accountId)
+}
+
+func TestEligibilitiesModel_flatten(t *testing.T) {
+	ctx := context.Background()
+	adminArn := "arn:aws:sso:::permissionSet/ssoins-1111111111111111/ps-1111111111111111"
+	readOnlyArn := "arn:aws:sso:::permissionSet/ssoins-1111111111111111/ps-2222222222222222"
+
+	eligibilities := []*awsteam.Eligibility{
+		{
+			Id:          ptr.String("group-1"),
+			Name:        ptr.String("admins"),
+			Type:        ptr.String(EligibilityGroupType),
+			Accounts:    []*awsteam.EligibilityAccount{{Id: ptr.String("123456789012"), Name: ptr.String("production")}},
+			Permissions: []*awsteam.EligibilityPermission{{Id: ptr.String(adminArn), Name: ptr.String("AdministratorAccess")}},
+			Duration:    ptr.Int64(2),
+		},
+		{
+			Id:          ptr.String("user-1"),
+			Name:        ptr.String("jane"),
+			Type:        ptr.String(EligibilityUserType),
+			OUs:         []*awsteam.EligibilityOU{{Id: ptr.String("ou-abcd-12345678"), Name: ptr.String("Workloads")}},
+			Permissions: []*awsteam.EligibilityPermission{{Id: ptr.String(readOnlyArn), Name: ptr.String("ReadOnly")}},
+		},
+		{
+			Id:          ptr.String("user-2"),
+			Name:        ptr.String("john"),
+			Type:        ptr.String(EligibilityUserType),
+			Accounts:    []*awsteam.EligibilityAccount{{Id: ptr.String("123456789012"), Name: ptr.String("production")}},
+			Permissions: []*awsteam.EligibilityPermission{{Id: ptr.String(readOnlyArn), Name: ptr.String("ReadOnly")}},
+		},
+	}
+
+	testCases := map[string]struct {
+		data       EligibilitiesModel
+		accountOUs map[string]bool
+		want       []string
+	}{
+		"all": {
+			want: []string{"group-1", "user-1", "user-2"},
+		},
+		"principal type": {
+			data: EligibilitiesModel{PrincipalType: types.StringValue(EligibilityUserType)},
+			want: []string{"user-1", "user-2"},
+		},
+		"principal id": {
+			data: EligibilitiesModel{PrincipalId: types.StringValue("user-1")},
+			want: []string{"user-1"},
+		},
+		"account and permission": {
+			data: EligibilitiesModel{AccountId: types.StringValue("123456789012"), PermissionArn: types.StringValue(adminArn)},
+			want: []string{"group-1"},
+		},
+		"account through ou": {
+			data:       EligibilitiesModel{AccountId: types.StringValue("210987654321")},
+			accountOUs: map[string]bool{"ou-abcd-12345678": true, "r-abcd": true},
+			want:       []string{"user-1"},
+		},
+		"account through ou and directly": {
+			data:       EligibilitiesModel{AccountId: types.StringValue("123456789012")},
+			accountOUs: map[string]bool{"ou-abcd-12345678": true, "r-abcd": true},
+			want:       []string{"group-1", "user-1", "user-2"},
+		},
+		"ou": {
+			data: EligibilitiesModel{OUId: types.StringValue("ou-abcd-12345678")},
+			want: []string{"user-1"},
+		},
+		"no match": {
+			data: EligibilitiesModel{OUId: types.StringValue("ou-abcd-12345678"), PrincipalType: types.StringValue(EligibilityGroupType)},
+			want: []string{},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			data := tc.data
+
+			if diags := data.flatten(eligibilities, tc.accountOUs); diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			var list []types.Object
+			if diags := data.Eligibilities.ElementsAs(ctx, &list, false); diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			if len(list) != len(tc.want) {
+				t.Fatalf("got %d eligibilities, want %d", len(list), len(tc.want))
+			}
+
+			for i, want := range tc.want {
+				got, _ := list[i].Attributes()["id"].(types.String)
+
+				if got.ValueString() != want {
+					t.Errorf("got %q at %d, want %q", got.ValueString(), i, want)
+				}
+			}
+		})
+	}
+}
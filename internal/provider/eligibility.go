@@ -1,12 +1,19 @@
 package provider
 
 import (
+	"context"
+	"fmt"
+	"sync"
+
 	"github.com/YakDriver/regexache"
+	"github.com/aws/smithy-go/ptr"
+	"github.com/awsteam-contrib/terraform-provider-awsteam/internal/names"
 	"github.com/awsteam-contrib/terraform-provider-awsteam/internal/sdk/awsteam"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
@@ -58,19 +65,17 @@ func AccountAttributeSet() schema.SetNestedAttribute {
 					Required:            true,
 					Validators: []validator.String{
 						stringvalidator.RegexMatches(
-							regexache.MustCompile(`\d{12}`),
+							regexache.MustCompile(`^\d{12}$`),
 							"value must be a valid aws account id.",
 						),
 					},
 				},
 				"account_name": schema.StringAttribute{
-					MarkdownDescription: "Name of the AWS account the eligibility policy will be applied to. This needs to match the name of the account number provided in account_id.",
-					Required:            true,
+					MarkdownDescription: "Name of the AWS account the eligibility policy will be applied to. This needs to match the name of the account number provided in account_id. When omitted, the name is looked up in the accounts known to AWS TEAM on every plan, so a renamed account is updated.",
+					Optional:            true,
+					Computed:            true,
 					Validators: []validator.String{
-						stringvalidator.RegexMatches(
-							regexache.MustCompile(`[\s\S]*`),
-							"value must be a valid aws account name.",
-						),
+						stringvalidator.LengthAtLeast(1),
 					},
 				},
 			},
@@ -89,19 +94,17 @@ func OUAttributeSet() schema.SetNestedAttribute {
 					Required:            true,
 					Validators: []validator.String{
 						stringvalidator.RegexMatches(
-							regexache.MustCompile(`^(r-[0-9a-z]{4,32})|(ou-[0-9a-z]{4,32}-[a-z0-9]{8,32})$`),
+							regexache.MustCompile(`^(r-[0-9a-z]{4,32}|ou-[0-9a-z]{4,32}-[a-z0-9]{8,32})$`),
 							"value must be a valid aws ou id.",
 						),
 					},
 				},
 				"ou_name": schema.StringAttribute{
-					MarkdownDescription: "Name of the OU the eligibility policy will be applied to. This needs to match the name of the id provided in ou_id.",
-					Required:            true,
+					MarkdownDescription: "Name of the OU the eligibility policy will be applied to. This needs to match the name of the id provided in ou_id. When omitted, the name is looked up in the OUs known to AWS TEAM on every plan, so a renamed OU is updated.",
+					Optional:            true,
+					Computed:            true,
 					Validators: []validator.String{
-						stringvalidator.RegexMatches(
-							regexache.MustCompile(`[\s\S]*`),
-							"value must be a valid aws ou name.",
-						),
+						stringvalidator.LengthAtLeast(1),
 					},
 				},
 			},
@@ -134,6 +137,286 @@ func PermissionAttributeSet() schema.SetNestedAttribute {
 	}
 }
 
+// eligibilityLookup requests the accounts, OUs and permission sets known to
+// TEAM at most once and keeps them keyed by id, or by ARN for permission sets.
+// It is shared by the resources of a provider configuration, which plan
+// concurrently.
+type eligibilityLookup struct {
+	client      *awsteam.Client
+	mu          sync.Mutex
+	accounts    map[string]string
+	ous         map[string]string
+	permissions map[string]string
+}
+
+func newEligibilityLookup(client *awsteam.Client) *eligibilityLookup {
+	return &eligibilityLookup{client: client}
+}
+
+func (l *eligibilityLookup) accountNames(ctx context.Context) (map[string]string, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.accounts != nil {
+		return l.accounts, nil
+	}
+
+	out, err := l.client.GetAccounts(ctx, &awsteam.GetAccountsInput{})
+
+	if err != nil {
+		return nil, err
+	}
+
+	l.accounts = map[string]string{}
+
+	for _, account := range out.Accounts {
+		if account != nil && account.Id != nil {
+			l.accounts[*account.Id] = ptr.ToString(account.Name)
+		}
+	}
+
+	return l.accounts, nil
+}
+
+func (l *eligibilityLookup) ouNames(ctx context.Context) (map[string]string, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.ous != nil {
+		return l.ous, nil
+	}
+
+	out, err := l.client.GetOUs(ctx, &awsteam.GetOUsInput{})
+
+	if err != nil {
+		return nil, err
+	}
+
+	l.ous = map[string]string{}
+
+	out.Root.Walk(func(ou *awsteam.OU, _ []*awsteam.OU) bool {
+		if ou.Id != nil {
+			l.ous[*ou.Id] = ptr.ToString(ou.Name)
+		}
+
+		return true
+	})
+
+	return l.ous, nil
+}
+
+func (l *eligibilityLookup) permissionNames(ctx context.Context) (map[string]string, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.permissions != nil {
+		return l.permissions, nil
+	}
+
+	out, err := l.client.GetPermissions(ctx, &awsteam.GetPermissionsInput{})
+
+	if err != nil {
+		return nil, err
+	}
+
+	l.permissions = map[string]string{}
+
+	for _, permission := range out.Permissions {
+		if permission != nil && permission.Arn != nil {
+			l.permissions[*permission.Arn] = ptr.ToString(permission.Name)
+		}
+	}
+
+	return l.permissions, nil
+}
+
+// fillEligibilityNames sets the account_name and ou_name of the elements of
+// accounts and ous that have a known id but no name. The names are taken from
+// the accounts and OUs known to TEAM, which are only requested when a name is
+// missing. An attribute error is reported for ids TEAM does not know.
+//
+// ModifyPlan passes the sets from config rather than plan, so names left out
+// of the config are looked up again on every plan instead of being kept from
+// state, and a renamed account or OU shows up as an update.
+func fillEligibilityNames(ctx context.Context, lookup *eligibilityLookup, accounts types.Set, ous types.Set) (types.Set, types.Set, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	accounts, d := fillElementNames(accounts, path.Root(names.AttrAccountSet), "account_id", "account_name", eligibilityAccountAttrTypes, func() (map[string]string, error) {
+		return lookup.accountNames(ctx)
+	})
+	diags.Append(d...)
+
+	ous, d = fillElementNames(ous, path.Root(names.AttrOUSet), "ou_id", "ou_name", eligibilityOUAttrTypes, func() (map[string]string, error) {
+		return lookup.ouNames(ctx)
+	})
+	diags.Append(d...)
+
+	return accounts, ous, diags
+}
+
+// validateEligibilityNames checks that every account, OU and permission set
+// with a known id and name is known to TEAM under that name.
+func validateEligibilityNames(ctx context.Context, lookup *eligibilityLookup, accounts types.Set, ous types.Set, permissions types.Set) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	diags.Append(validateElementNames(accounts, path.Root(names.AttrAccountSet), "account_id", "account_name", "account", func() (map[string]string, error) {
+		return lookup.accountNames(ctx)
+	})...)
+
+	diags.Append(validateElementNames(ous, path.Root(names.AttrOUSet), "ou_id", "ou_name", "OU", func() (map[string]string, error) {
+		return lookup.ouNames(ctx)
+	})...)
+
+	diags.Append(validateElementNames(permissions, path.Root(names.AttrPermissionSet), "permission_arn", "permission_name", "permission set", func() (map[string]string, error) {
+		return lookup.permissionNames(ctx)
+	})...)
+
+	return diags
+}
+
+// validateElementNames reports an attribute error for each element of set
+// whose idAttr is unknown to list or whose nameAttr differs from the name list
+// returns for it. Elements with a null or unknown id or name are skipped.
+func validateElementNames(set types.Set, setPath path.Path, idAttr string, nameAttr string, noun string, list func() (map[string]string, error)) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if set.IsNull() || set.IsUnknown() {
+		return diags
+	}
+
+	var namesById map[string]string
+
+	for _, elem := range set.Elements() {
+		obj, ok := elem.(types.Object)
+
+		if !ok || obj.IsNull() || obj.IsUnknown() {
+			continue
+		}
+
+		id, _ := obj.Attributes()[idAttr].(types.String)
+		name, _ := obj.Attributes()[nameAttr].(types.String)
+
+		if id.IsNull() || id.IsUnknown() || name.IsNull() || name.IsUnknown() {
+			continue
+		}
+
+		if namesById == nil {
+			var err error
+
+			namesById, err = list()
+
+			if err != nil {
+				diags.Append(clientErrorDiagnostic(fmt.Sprintf("Unable to validate %s", nameAttr), err))
+				return diags
+			}
+		}
+
+		want, found := namesById[id.ValueString()]
+
+		if !found {
+			diags.AddAttributeError(
+				setPath.AtSetValue(elem).AtName(idAttr),
+				"Unknown Id",
+				fmt.Sprintf("The %s %q is not known to AWS TEAM.", noun, id.ValueString()),
+			)
+			continue
+		}
+
+		if want != name.ValueString() {
+			diags.AddAttributeError(
+				setPath.AtSetValue(elem).AtName(nameAttr),
+				"Name Mismatch",
+				fmt.Sprintf("The %s %q is named %q in AWS TEAM, not %q.", noun, id.ValueString(), want, name.ValueString()),
+			)
+		}
+	}
+
+	return diags
+}
+
+// fillElementNames sets nameAttr in each element of set whose name is null or
+// unknown to the name that list returns for the element's idAttr.
+func fillElementNames(set types.Set, setPath path.Path, idAttr string, nameAttr string, attrTypes map[string]attr.Type, list func() (map[string]string, error)) (types.Set, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if set.IsNull() || set.IsUnknown() {
+		return set, diags
+	}
+
+	var namesById map[string]string
+
+	elems := []attr.Value{}
+
+	for _, elem := range set.Elements() {
+		obj, ok := elem.(types.Object)
+
+		if !ok || obj.IsNull() || obj.IsUnknown() {
+			elems = append(elems, elem)
+			continue
+		}
+
+		id, _ := obj.Attributes()[idAttr].(types.String)
+		name, _ := obj.Attributes()[nameAttr].(types.String)
+
+		// The name of an id that is only known during apply is computed by apply.
+		if id.IsUnknown() && name.IsNull() {
+			unknown, d := types.ObjectValue(attrTypes, map[string]attr.Value{
+				idAttr:   id,
+				nameAttr: types.StringUnknown(),
+			})
+			diags.Append(d...)
+
+			elems = append(elems, unknown)
+			continue
+		}
+
+		if id.IsNull() || id.IsUnknown() || (!name.IsNull() && !name.IsUnknown()) {
+			elems = append(elems, elem)
+			continue
+		}
+
+		if namesById == nil {
+			var err error
+
+			namesById, err = list()
+
+			if err != nil {
+				diags.Append(clientErrorDiagnostic(fmt.Sprintf("Unable to look up %s", nameAttr), err))
+				return set, diags
+			}
+		}
+
+		resolved, found := namesById[id.ValueString()]
+
+		if !found {
+			diags.AddAttributeError(
+				setPath.AtSetValue(elem).AtName(idAttr),
+				"Unknown Id",
+				fmt.Sprintf("The id %q is not part of the organization known to AWS TEAM, so its %s cannot be looked up.", id.ValueString(), nameAttr),
+			)
+			elems = append(elems, elem)
+			continue
+		}
+
+		filled, d := types.ObjectValue(attrTypes, map[string]attr.Value{
+			idAttr:   id,
+			nameAttr: types.StringValue(resolved),
+		})
+		diags.Append(d...)
+
+		elems = append(elems, filled)
+	}
+
+	if diags.HasError() {
+		return set, diags
+	}
+
+	filled, d := types.SetValue(types.ObjectType{AttrTypes: attrTypes}, elems)
+	diags.Append(d...)
+
+	return filled, diags
+}
+
 func expandEligibilityAccounts(raw []*EligibilityAccount) []*awsteam.EligibilityAccount {
 	var accounts []*awsteam.EligibilityAccount
 
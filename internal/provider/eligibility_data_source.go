@@ -0,0 +1,171 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/smithy-go/ptr"
+	"github.com/awsteam-contrib/terraform-provider-awsteam/internal/sdk/awsteam"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ datasource.DataSource = &EligibilityDataSource{}
+
+func NewEligibilityDataSource() datasource.DataSource {
+	return &EligibilityDataSource{}
+}
+
+type EligibilityDataSource struct {
+	client *awsteam.Client
+}
+
+type EligibilityModel struct {
+	Id               types.String `tfsdk:"id"`
+	Name             types.String `tfsdk:"name"`
+	Type             types.String `tfsdk:"type"`
+	UserId           types.String `tfsdk:"user_id"`
+	UserName         types.String `tfsdk:"user_name"`
+	GroupId          types.String `tfsdk:"group_id"`
+	GroupName        types.String `tfsdk:"group_name"`
+	Accounts         types.Set    `tfsdk:"accounts"`
+	OUs              types.Set    `tfsdk:"ous"`
+	Permissions      types.Set    `tfsdk:"permissions"`
+	TicketNo         types.String `tfsdk:"ticket_no"`
+	ApprovalRequired types.Bool   `tfsdk:"approval_required"`
+	Duration         types.Int64  `tfsdk:"duration"`
+	ModifiedBy       types.String `tfsdk:"modified_by"`
+	CreatedAt        types.String `tfsdk:"created_at"`
+	UpdatedAt        types.String `tfsdk:"updated_at"`
+}
+
+func (d *EligibilityDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_eligibility"
+}
+
+func (d *EligibilityDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := eligibilityAttributes()
+	attributes["id"] = schema.StringAttribute{
+		MarkdownDescription: "The id of the IAM Identity Center user or group whose eligibility policy is read. This is the `id` of an `awsteam_eligibility_user` or `awsteam_eligibility_group`.",
+		Required:            true,
+	}
+	attributes["user_id"] = schema.StringAttribute{
+		MarkdownDescription: "Id of the IAM Identity Center user the eligibility policy applies to, as in `awsteam_eligibility_user`. Only set when `type` is `User`.",
+		Computed:            true,
+	}
+	attributes["user_name"] = schema.StringAttribute{
+		MarkdownDescription: "Name of the IAM Identity Center user the eligibility policy applies to, as in `awsteam_eligibility_user`. Only set when `type` is `User`.",
+		Computed:            true,
+	}
+	attributes["group_id"] = schema.StringAttribute{
+		MarkdownDescription: "Id of the IAM Identity Center group the eligibility policy applies to, as in `awsteam_eligibility_group`. Only set when `type` is `Group`.",
+		Computed:            true,
+	}
+	attributes["group_name"] = schema.StringAttribute{
+		MarkdownDescription: "Name of the IAM Identity Center group the eligibility policy applies to, as in `awsteam_eligibility_group`. Only set when `type` is `Group`.",
+		Computed:            true,
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Provides a data source for the eligibility policy of an IAM Identity Center user or group within an AWS TEAM deployment.",
+
+		Attributes: attributes,
+	}
+}
+
+func (d *EligibilityDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	meta, ok := req.ProviderData.(*AWSTEAMClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.AWSTEAMClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = meta.Client
+}
+
+func (d *EligibilityDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data EligibilityModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in := &awsteam.GetEligibilityInput{
+		Id: data.Id.ValueStringPointer(),
+	}
+
+	out, err := d.client.GetEligibility(ctx, in)
+
+	if isNotFound(err) {
+		resp.Diagnostics.AddError("Eligibility Not Found", fmt.Sprintf("AWS TEAM has no eligibility policy for the user or group %q.", data.Id.ValueString()))
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.Append(clientErrorDiagnostic("Unable to read eligibility", err))
+		return
+	}
+
+	resp.Diagnostics.Append(data.flatten(ctx, out.Eligibility)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "read eligibility data source")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *EligibilityModel) flatten(ctx context.Context, eligibility *awsteam.Eligibility) diag.Diagnostics {
+	obj, diags := flattenEligibility(eligibility)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	attrTypes := obj.AttributeTypes(ctx)
+	attrs := obj.Attributes()
+
+	for _, name := range []string{"user_id", "user_name", "group_id", "group_name"} {
+		attrTypes[name] = types.StringType
+		attrs[name] = types.StringNull()
+	}
+
+	// The principal is also exposed under the attributes of the resource
+	// managing the eligibility policy.
+	switch ptr.ToString(eligibility.Type) {
+	case EligibilityUserType:
+		attrs["user_id"] = types.StringPointerValue(eligibility.Id)
+		attrs["user_name"] = types.StringPointerValue(eligibility.Name)
+	case EligibilityGroupType:
+		attrs["group_id"] = types.StringPointerValue(eligibility.Id)
+		attrs["group_name"] = types.StringPointerValue(eligibility.Name)
+	}
+
+	obj, objDiags := types.ObjectValue(attrTypes, attrs)
+	diags.Append(objDiags...)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	diags.Append(obj.As(ctx, d, basetypes.ObjectAsOptions{})...)
+
+	return diags
+}
@@ -0,0 +1,134 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/smithy-go/ptr"
+	"github.com/awsteam-contrib/terraform-provider-awsteam/internal/sdk/awsteam"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccEligibilityDataSource_basic(t *testing.T) {
+	dataSourceName := "data.awsteam_eligibility.test"
+	user := gofakeit.Email()
+	userId := gofakeit.UUID()
+	duration := fmt.Sprint(gofakeit.Number(1, 10))
+	ticketNo := gofakeit.BS()
+	accountId := gofakeit.DigitN(12)
+	accountName := gofakeit.BS()
+	ouId := "ou-cxt3-2782ty5g" // hard coded fake ou id
+	ouName := gofakeit.BS()
+	permissionArn := "arn:aws:sso:::permissionSet/ssoins-4334d1f197f50907/ps-f5ge203d3d2428d3" // hard coded fake arn
+	permissionName := "elevated"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEligibilityUserResourceConfig(user, userId, false, duration, ticketNo, accountId, accountName, ouId, ouName, permissionArn, permissionName) +
+					testAccEligibilityDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "id", userId),
+					resource.TestCheckResourceAttr(dataSourceName, "name", user),
+					resource.TestCheckResourceAttr(dataSourceName, "type", EligibilityUserType),
+					resource.TestCheckResourceAttrPair(dataSourceName, "user_id", "awsteam_eligibility_user.test", "user_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "user_name", "awsteam_eligibility_user.test", "user_name"),
+					resource.TestCheckNoResourceAttr(dataSourceName, "group_id"),
+					resource.TestCheckNoResourceAttr(dataSourceName, "group_name"),
+					resource.TestCheckResourceAttr(dataSourceName, "approval_required", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "duration", duration),
+					resource.TestCheckResourceAttr(dataSourceName, "ticket_no", ticketNo),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "ous.*",
+						map[string]string{
+							"ou_id":   ouId,
+							"ou_name": ouName,
+						}),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "permissions.*",
+						map[string]string{
+							"permission_arn":  permissionArn,
+							"permission_name": permissionName,
+						}),
+					resource.TestCheckResourceAttrSet(dataSourceName, "created_at"),
+				),
+			},
+		},
+	})
+}
+
+func testAccEligibilityDataSourceConfig() string {
+	return `
+data "awsteam_eligibility" "test" {
+  id = awsteam_eligibility_user.test.id
+}`
+}
+
+func TestEligibilityModel_flatten(t *testing.T) {
+	ctx := context.Background()
+	eligibility := &awsteam.Eligibility{
+		Id:               ptr.String("group-1"),
+		Name:             ptr.String("admins"),
+		Type:             ptr.String(EligibilityGroupType),
+		Accounts:         []*awsteam.EligibilityAccount{{Id: ptr.String("123456789012"), Name: ptr.String("production")}},
+		OUs:              []*awsteam.EligibilityOU{{Id: ptr.String("ou-abcd-12345678"), Name: ptr.String("Workloads")}},
+		Permissions:      []*awsteam.EligibilityPermission{{Id: ptr.String("arn:aws:sso:::permissionSet/ssoins-1111111111111111/ps-1111111111111111"), Name: ptr.String("AdministratorAccess")}},
+		ApprovalRequired: ptr.Bool(true),
+		Duration:         ptr.Int64(4),
+	}
+
+	var data EligibilityModel
+
+	if diags := data.flatten(ctx, eligibility); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if data.Id.ValueString() != "group-1" || data.Name.ValueString() != "admins" || data.Type.ValueString() != EligibilityGroupType {
+		t.Errorf("got id %s, name %s and type %s", data.Id, data.Name, data.Type)
+	}
+
+	if data.GroupId.ValueString() != "group-1" || data.GroupName.ValueString() != "admins" {
+		t.Errorf("got group id %s and group name %s", data.GroupId, data.GroupName)
+	}
+
+	if !data.UserId.IsNull() || !data.UserName.IsNull() {
+		t.Errorf("got user id %s and user name %s, want null", data.UserId, data.UserName)
+	}
+
+	if !data.ApprovalRequired.ValueBool() || data.Duration.ValueInt64() != 4 {
+		t.Errorf("got approval required %s and duration %s", data.ApprovalRequired, data.Duration)
+	}
+
+	if len(data.Accounts.Elements()) != 1 || len(data.OUs.Elements()) != 1 || len(data.Permissions.Elements()) != 1 {
+		t.Errorf("got accounts %s, ous %s and permissions %s", data.Accounts, data.OUs, data.Permissions)
+	}
+
+	if !data.TicketNo.IsNull() {
+		t.Errorf("got ticket number %s, want null", data.TicketNo)
+	}
+}
+
+func TestEligibilityModel_flattenUser(t *testing.T) {
+	ctx := context.Background()
+	eligibility := &awsteam.Eligibility{
+		Id:   ptr.String("user-1"),
+		Name: ptr.String("jane.doe@contoso.com"),
+		Type: ptr.String(EligibilityUserType),
+	}
+
+	var data EligibilityModel
+
+	if diags := data.flatten(ctx, eligibility); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if data.UserId.ValueString() != "user-1" || data.UserName.ValueString() != "jane.doe@contoso.com" {
+		t.Errorf("got user id %s and user name %s", data.UserId, data.UserName)
+	}
+
+	if !data.GroupId.IsNull() || !data.GroupName.IsNull() {
+		t.Errorf("got group id %s and group name %s, want null", data.GroupId, data.GroupName)
+	}
+}
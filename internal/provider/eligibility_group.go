@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"reflect"
 
-	"github.com/YakDriver/regexache"
 	"github.com/aws/smithy-go/ptr"
 	"github.com/awsteam-contrib/terraform-provider-awsteam/internal/names"
 	"github.com/awsteam-contrib/terraform-provider-awsteam/internal/sdk/awsteam"
@@ -29,13 +28,17 @@ const (
 var _ resource.Resource = &EligibilityGroupResource{}
 var _ resource.ResourceWithImportState = &EligibilityGroupResource{}
 var _ resource.ResourceWithValidateConfig = &EligibilityGroupResource{}
+var _ resource.ResourceWithModifyPlan = &EligibilityGroupResource{}
 
 func NewEligibilityGroupResource() resource.Resource {
 	return &EligibilityGroupResource{}
 }
 
 type EligibilityGroupResource struct {
-	client *awsteam.Client
+	client     *awsteam.Client
+	lookup     *eligibilityLookup
+	principals *identityCenterLookup
+	validate   bool
 }
 
 type EligibilityGroupModel struct {
@@ -75,22 +78,23 @@ func (r *EligibilityGroupResource) Schema(ctx context.Context, req resource.Sche
 				Required:            true,
 			},
 			"group_name": schema.StringAttribute{
-				MarkdownDescription: "Name of the AWS iam identity center group the eligibility policy will be applied to.",
-				Required:            true,
+				MarkdownDescription: "Name of the AWS iam identity center group the eligibility policy will be applied to. Either `group_name` or `group_id` must be provided; when only `group_id` is, the name is looked up in IAM Identity Center during plan.",
+				Optional:            true,
+				Computed:            true,
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 				Validators: []validator.String{
-					stringvalidator.RegexMatches(
-						regexache.MustCompile(`[\s\S]*`),
-						"value must be a valid aws group name.",
-					),
+					stringvalidator.LengthAtLeast(1),
 				},
 			},
 			"group_id": schema.StringAttribute{
-				MarkdownDescription: "Id of the AWS iam identity center group the eligibility policy will be applied to.",
-				Required:            true,
+				MarkdownDescription: "Id of the AWS iam identity center group the eligibility policy will be applied to. Either `group_name` or `group_id` must be provided; when only `group_name` is, the id is looked up in IAM Identity Center during plan. When both are provided, the plan fails if IAM Identity Center does not know the group or assigns them to different groups.",
+				Optional:            true,
+				Computed:            true,
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
@@ -127,6 +131,76 @@ func (r *EligibilityGroupResource) ValidateConfig(ctx context.Context, req resou
 			accountsAndOUsRequiredMessageDetail,
 		)
 	}
+
+	if config.GroupName.IsNull() && config.GroupId.IsNull() {
+		resp.Diagnostics.AddError(
+			"Missing Group",
+			"Either group_name or group_id must be provided.",
+		)
+	}
+}
+
+func (r *EligibilityGroupResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var config, plan EligibilityGroupModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Values that are only known during apply are resolved by Create.
+	resolveGroup := !config.GroupName.IsUnknown() && !config.GroupId.IsUnknown()
+
+	if resolveGroup && !req.State.Raw.IsNull() {
+		var state EligibilityGroupModel
+
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		// The group has not changed, the plan already holds the values from state.
+		if (config.GroupName.IsNull() || config.GroupName.Equal(state.GroupName)) && (config.GroupId.IsNull() || config.GroupId.Equal(state.GroupId)) {
+			resolveGroup = false
+		}
+	}
+
+	if resolveGroup {
+		name, id, diags := resolveIdCGroup(ctx, r.principals, config.GroupName, config.GroupId, path.Root("group_name"), path.Root("group_id"))
+
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		plan.GroupName = name
+		plan.GroupId = id
+	}
+
+	// Names omitted in config are resolved again, so renames are not hidden by state.
+	accounts, ous, diags := fillEligibilityNames(ctx, r.lookup, config.Accounts, config.OUs)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Accounts = accounts
+	plan.OUs = ous
+
+	if r.validate {
+		resp.Diagnostics.Append(validateEligibilityNames(ctx, r.lookup, plan.Accounts, plan.OUs, plan.Permissions)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
 }
 
 func (r *EligibilityGroupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -134,18 +208,21 @@ func (r *EligibilityGroupResource) Configure(ctx context.Context, req resource.C
 		return
 	}
 
-	client, ok := req.ProviderData.(*awsteam.Client)
+	meta, ok := req.ProviderData.(*AWSTEAMClient)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *awsteam.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *provider.AWSTEAMClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = client
+	r.client = meta.Client
+	r.lookup = meta.eligibilityLookup()
+	r.principals = meta.identityCenterLookup()
+	r.validate = meta.ValidateEligibilities
 }
 
 func (r *EligibilityGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -157,6 +234,28 @@ func (r *EligibilityGroupResource) Create(ctx context.Context, req resource.Crea
 		return
 	}
 
+	if data.GroupName.IsUnknown() || data.GroupId.IsUnknown() {
+		name, id, diags := resolveIdCGroup(ctx, r.principals, data.GroupName, data.GroupId, path.Root("group_name"), path.Root("group_id"))
+
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		data.GroupName = name
+		data.GroupId = id
+	}
+
+	filledAccounts, filledOUs, fillDiags := fillEligibilityNames(ctx, r.lookup, data.Accounts, data.OUs)
+
+	resp.Diagnostics.Append(fillDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Accounts = filledAccounts
+	data.OUs = filledOUs
+
 	var accounts []*EligibilityAccount
 	var ous []*EligibilityOU
 	var permissions []*EligibilityPermission
@@ -192,7 +291,7 @@ func (r *EligibilityGroupResource) Create(ctx context.Context, req resource.Crea
 	out, err := r.client.CreateEligibility(ctx, in)
 
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create eligibility group, got error: %s", err))
+		resp.Diagnostics.Append(clientErrorDiagnostic("Unable to create eligibility group", err))
 		return
 	}
 
@@ -231,8 +330,14 @@ func (r *EligibilityGroupResource) Read(ctx context.Context, req resource.ReadRe
 
 	out, err := r.client.GetEligibility(ctx, in)
 
+	if isNotFound(err) {
+		resp.Diagnostics.AddWarning("Read Error", "Received empty Eligibility. Removing from state.")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read eligibility group policy, got error: %s", err))
+		resp.Diagnostics.Append(clientErrorDiagnostic("Unable to read eligibility group policy", err))
 		return
 	}
 
@@ -286,6 +391,16 @@ func (r *EligibilityGroupResource) Update(ctx context.Context, req resource.Upda
 	}
 
 	if updateRequired {
+		filledAccounts, filledOUs, fillDiags := fillEligibilityNames(ctx, r.lookup, plan.Accounts, plan.OUs)
+
+		resp.Diagnostics.Append(fillDiags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		plan.Accounts = filledAccounts
+		plan.OUs = filledOUs
+
 		var accounts []*EligibilityAccount
 		var ous []*EligibilityOU
 		var permissions []*EligibilityPermission
@@ -321,7 +436,7 @@ func (r *EligibilityGroupResource) Update(ctx context.Context, req resource.Upda
 		out, err := r.client.UpdateEligibility(ctx, in)
 
 		if err != nil {
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update eligibility group, got error: %s", err))
+			resp.Diagnostics.Append(clientErrorDiagnostic("Unable to update eligibility group", err))
 			return
 		}
 
@@ -364,8 +479,8 @@ func (r *EligibilityGroupResource) Delete(ctx context.Context, req resource.Dele
 
 	_, err := r.client.DeleteEligibility(ctx, in)
 
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete eligibility group, got error: %s", err))
+	if err != nil && !isAlreadyDeleted(err) {
+		resp.Diagnostics.Append(clientErrorDiagnostic("Unable to delete eligibility group", err))
 		return
 	}
 }
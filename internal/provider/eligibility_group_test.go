@@ -6,25 +6,24 @@ import (
 	"regexp"
 	"testing"
 
-	"github.com/brianvoe/gofakeit/v6"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
 func TestAccEligibilityGroupResource_basic(t *testing.T) {
+	faker := testAccFaker(t)
 	ctx := context.Background()
 	resourceName := "awsteam_eligibility_group.test"
-	group1 := gofakeit.Email()
-	group2 := gofakeit.Email()
-	groupId1 := gofakeit.UUID()
-	groupId2 := gofakeit.UUID()
+	groups := testAccIdentityCenterGroups(t, faker, 2)
+	group1, groupId1 := groups[0].Name, groups[0].Id
+	group2, groupId2 := groups[1].Name, groups[1].Id
 	approval1 := true
 	approval2 := false
-	duration := fmt.Sprint(gofakeit.Number(1, 10))
-	ticketNo := gofakeit.BS()
-	accountId := gofakeit.DigitN(12)
-	accountName := gofakeit.BS()
+	duration := fmt.Sprint(faker.Number(1, 10))
+	ticketNo := faker.BS()
+	accountId := faker.DigitN(12)
+	accountName := faker.BS()
 	ouId := "ou-cxt3-2782ty5g" // hard coded fake ou id
-	ouName := gofakeit.BS()
+	ouName := faker.BS()
 	permissionArn := "arn:aws:sso:::permissionSet/ssoins-4334d1f197f50907/ps-f5ge203d3d2428d3" // hard coded fake arn
 	permissionName := "elevated"
 
@@ -78,11 +77,12 @@ func TestAccEligibilityGroupResource_basic(t *testing.T) {
 }
 
 func TestAccEligibilityGroupResource_missingAccountsAndOUs(t *testing.T) {
-	group1 := gofakeit.Email()
-	groupId1 := gofakeit.UUID()
+	faker := testAccFaker(t)
+	group := testAccIdentityCenterGroups(t, faker, 1)[0]
+	group1, groupId1 := group.Name, group.Id
 	approval1 := true
-	duration := fmt.Sprint(gofakeit.Number(1, 10))
-	ticketNo := gofakeit.BS()
+	duration := fmt.Sprint(faker.Number(1, 10))
+	ticketNo := faker.BS()
 	permissionArn := "arn:aws:sso:::permissionSet/ssoins-4334d1f197f50907/ps-f5ge203d3d2428d3" // hard coded fake arn
 	permissionName := "elevated"
 
@@ -107,15 +107,16 @@ func TestAccEligibilityGroupResource_missingAccountsAndOUs(t *testing.T) {
 }
 
 func TestAccEligibilityGroupResource_missingAccounts(t *testing.T) {
+	faker := testAccFaker(t)
 	ctx := context.Background()
 	resourceName := "awsteam_eligibility_group.test"
-	group1 := gofakeit.Email()
-	groupId1 := gofakeit.UUID()
+	group := testAccIdentityCenterGroups(t, faker, 1)[0]
+	group1, groupId1 := group.Name, group.Id
 	approval1 := true
-	duration := fmt.Sprint(gofakeit.Number(1, 10))
-	ticketNo := gofakeit.BS()
+	duration := fmt.Sprint(faker.Number(1, 10))
+	ticketNo := faker.BS()
 	ouId := "ou-cxt3-2782ty5g" // hard coded fake ou id
-	ouName := gofakeit.BS()
+	ouName := faker.BS()
 	permissionArn := "arn:aws:sso:::permissionSet/ssoins-4334d1f197f50907/ps-f5ge203d3d2428d3" // hard coded fake arn
 	permissionName := "elevated"
 
@@ -158,15 +159,16 @@ func TestAccEligibilityGroupResource_missingAccounts(t *testing.T) {
 }
 
 func TestAccEligibilityGroupResource_missingOUs(t *testing.T) {
+	faker := testAccFaker(t)
 	ctx := context.Background()
 	resourceName := "awsteam_eligibility_group.test"
-	group1 := gofakeit.Email()
-	groupId1 := gofakeit.UUID()
+	group := testAccIdentityCenterGroups(t, faker, 1)[0]
+	group1, groupId1 := group.Name, group.Id
 	approval1 := true
-	duration := fmt.Sprint(gofakeit.Number(1, 10))
-	ticketNo := gofakeit.BS()
-	accountId := gofakeit.DigitN(12)
-	accountName := gofakeit.BS()
+	duration := fmt.Sprint(faker.Number(1, 10))
+	ticketNo := faker.BS()
+	accountId := faker.DigitN(12)
+	accountName := faker.BS()
 	permissionArn := "arn:aws:sso:::permissionSet/ssoins-4334d1f197f50907/ps-f5ge203d3d2428d3" // hard coded fake arn
 	permissionName := "elevated"
 
@@ -209,15 +211,16 @@ func TestAccEligibilityGroupResource_missingOUs(t *testing.T) {
 }
 
 func TestAccEligibilityGroupResource_emptyAccounts(t *testing.T) {
+	faker := testAccFaker(t)
 	ctx := context.Background()
 	resourceName := "awsteam_eligibility_group.test"
-	group1 := gofakeit.Email()
-	groupId1 := gofakeit.UUID()
+	group := testAccIdentityCenterGroups(t, faker, 1)[0]
+	group1, groupId1 := group.Name, group.Id
 	approval1 := true
-	duration := fmt.Sprint(gofakeit.Number(1, 10))
-	ticketNo := gofakeit.BS()
+	duration := fmt.Sprint(faker.Number(1, 10))
+	ticketNo := faker.BS()
 	ouId := "ou-cxt3-2782ty5g" // hard coded fake ou id
-	ouName := gofakeit.BS()
+	ouName := faker.BS()
 	permissionArn := "arn:aws:sso:::permissionSet/ssoins-4334d1f197f50907/ps-f5ge203d3d2428d3" // hard coded fake arn
 	permissionName := "elevated"
 
@@ -260,15 +263,16 @@ func TestAccEligibilityGroupResource_emptyAccounts(t *testing.T) {
 }
 
 func TestAccEligibilityGroupResource_emptyOUs(t *testing.T) {
+	faker := testAccFaker(t)
 	ctx := context.Background()
 	resourceName := "awsteam_eligibility_group.test"
-	group1 := gofakeit.Email()
-	groupId1 := gofakeit.UUID()
+	group := testAccIdentityCenterGroups(t, faker, 1)[0]
+	group1, groupId1 := group.Name, group.Id
 	approval1 := true
-	duration := fmt.Sprint(gofakeit.Number(1, 10))
-	ticketNo := gofakeit.BS()
-	accountId := gofakeit.DigitN(12)
-	accountName := gofakeit.BS()
+	duration := fmt.Sprint(faker.Number(1, 10))
+	ticketNo := faker.BS()
+	accountId := faker.DigitN(12)
+	accountName := faker.BS()
 	permissionArn := "arn:aws:sso:::permissionSet/ssoins-4334d1f197f50907/ps-f5ge203d3d2428d3" // hard coded fake arn
 	permissionName := "elevated"
 
@@ -311,15 +315,16 @@ func TestAccEligibilityGroupResource_emptyOUs(t *testing.T) {
 }
 
 func TestAccEligibilityGroupResource_Accounts(t *testing.T) {
+	faker := testAccFaker(t)
 	ctx := context.Background()
 	resourceName := "awsteam_eligibility_group.test"
-	group1 := gofakeit.Email()
-	groupId1 := gofakeit.UUID()
+	group := testAccIdentityCenterGroups(t, faker, 1)[0]
+	group1, groupId1 := group.Name, group.Id
 	approval1 := true
-	duration := fmt.Sprint(gofakeit.Number(1, 10))
-	ticketNo := gofakeit.BS()
+	duration := fmt.Sprint(faker.Number(1, 10))
+	ticketNo := faker.BS()
 	ouId := "ou-cxt3-2782ty5g" // hard coded fake ou id
-	ouName := gofakeit.BS()
+	ouName := faker.BS()
 	permissionArn := "arn:aws:sso:::permissionSet/ssoins-4334d1f197f50907/ps-f5ge203d3d2428d3" // hard coded fake arn
 	permissionName := "elevated"
 
@@ -361,17 +366,18 @@ func TestAccEligibilityGroupResource_Accounts(t *testing.T) {
 }
 
 func TestAccEligibilityGroupResource_disappears(t *testing.T) {
+	faker := testAccFaker(t)
 	ctx := context.Background()
 	resourceName := "awsteam_eligibility_group.test"
-	group1 := gofakeit.Email()
-	groupId := gofakeit.UUID()
+	group := testAccIdentityCenterGroups(t, faker, 1)[0]
+	group1, groupId := group.Name, group.Id
 	approval1 := true
-	duration := fmt.Sprint(gofakeit.Number(1, 10))
-	ticketNo := gofakeit.BS()
-	accountId := gofakeit.DigitN(12)
-	accountName := gofakeit.BS()
+	duration := fmt.Sprint(faker.Number(1, 10))
+	ticketNo := faker.BS()
+	accountId := faker.DigitN(12)
+	accountName := faker.BS()
 	ouId := "ou-cxt3-2782ty5g" // hard coded fake ou id
-	ouName := gofakeit.BS()
+	ouName := faker.BS()
 	permissionArn := "arn:aws:sso:::permissionSet/ssoins-4334d1f197f50907/ps-f5ge203d3d2428d3" // hard coded fake arn
 	permissionName := "elevated"
 
@@ -2,11 +2,19 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
 
 	"github.com/aws/smithy-go/ptr"
 	"github.com/awsteam-contrib/terraform-provider-awsteam/internal/acctest"
 	"github.com/awsteam-contrib/terraform-provider-awsteam/internal/sdk/awsteam"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
@@ -22,7 +30,12 @@ func testAccEligibilityResourceExists(ctx context.Context, resourceName string)
 			return fmt.Errorf("Resource (%s) ID not set", resourceName)
 		}
 
-		client := acctest.NewAWSTeamClient(ctx)
+		client, err := acctest.NewAWSTeamClient(ctx)
+
+		if err != nil {
+			return err
+		}
+
 		out, err := client.GetEligibility(ctx, &awsteam.GetEligibilityInput{Id: ptr.String(rs.Primary.ID)})
 
 		if err != nil {
@@ -52,8 +65,13 @@ func testAccEligibilityResourceDisappears(ctx context.Context, resourceName stri
 			return fmt.Errorf("Resource (%s) ID not set", resourceName)
 		}
 
-		client := acctest.NewAWSTeamClient(ctx)
-		_, err := client.DeleteEligibility(ctx, &awsteam.DeleteEligibilityInput{Id: ptr.String(rs.Primary.ID)})
+		client, err := acctest.NewAWSTeamClient(ctx)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = client.DeleteEligibility(ctx, &awsteam.DeleteEligibilityInput{Id: ptr.String(rs.Primary.ID)})
 
 		if err != nil {
 			return err
@@ -62,3 +80,245 @@ func testAccEligibilityResourceDisappears(ctx context.Context, resourceName stri
 		return nil
 	}
 }
+
+func TestFillElementNames(t *testing.T) {
+	ctx := context.Background()
+	elemType := types.ObjectType{AttrTypes: eligibilityAccountAttrTypes}
+	account := func(id types.String, name types.String) attr.Value {
+		return types.ObjectValueMust(eligibilityAccountAttrTypes, map[string]attr.Value{"account_id": id, "account_name": name})
+	}
+
+	accountNames := map[string]string{
+		"111111111111": "production",
+		"222222222222": "staging",
+	}
+
+	t.Run("fills missing names", func(t *testing.T) {
+		calls := 0
+		set := types.SetValueMust(elemType, []attr.Value{
+			account(types.StringValue("111111111111"), types.StringUnknown()),
+			account(types.StringValue("222222222222"), types.StringNull()),
+			account(types.StringValue("333333333333"), types.StringValue("given")),
+		})
+
+		filled, diags := fillElementNames(set, path.Root("accounts"), "account_id", "account_name", eligibilityAccountAttrTypes, func() (map[string]string, error) {
+			calls++
+			return accountNames, nil
+		})
+
+		if diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+
+		if calls != 1 {
+			t.Errorf("got %d lookups, want 1", calls)
+		}
+
+		var accounts []EligibilityAccount
+		if diags := filled.ElementsAs(ctx, &accounts, false); diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+
+		want := map[string]string{"111111111111": "production", "222222222222": "staging", "333333333333": "given"}
+		for _, a := range accounts {
+			if got := a.AccountName.ValueString(); got != want[a.AccountId.ValueString()] {
+				t.Errorf("got name %q for %s", got, a.AccountId.ValueString())
+			}
+		}
+	})
+
+	t.Run("no lookup when names are known", func(t *testing.T) {
+		set := types.SetValueMust(elemType, []attr.Value{
+			account(types.StringValue("111111111111"), types.StringValue("production")),
+			account(types.StringUnknown(), types.StringUnknown()),
+		})
+
+		filled, diags := fillElementNames(set, path.Root("accounts"), "account_id", "account_name", eligibilityAccountAttrTypes, func() (map[string]string, error) {
+			return nil, errors.New("unexpected lookup")
+		})
+
+		if diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+
+		if !filled.Equal(set) {
+			t.Errorf("got %s, want %s", filled, set)
+		}
+	})
+
+	t.Run("name of an unknown id", func(t *testing.T) {
+		set := types.SetValueMust(elemType, []attr.Value{
+			account(types.StringUnknown(), types.StringNull()),
+		})
+
+		filled, diags := fillElementNames(set, path.Root("accounts"), "account_id", "account_name", eligibilityAccountAttrTypes, func() (map[string]string, error) {
+			return nil, errors.New("unexpected lookup")
+		})
+
+		if diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+
+		want := types.SetValueMust(elemType, []attr.Value{
+			account(types.StringUnknown(), types.StringUnknown()),
+		})
+
+		if !filled.Equal(want) {
+			t.Errorf("got %s, want %s", filled, want)
+		}
+	})
+
+	t.Run("unknown account id", func(t *testing.T) {
+		set := types.SetValueMust(elemType, []attr.Value{
+			account(types.StringValue("999999999999"), types.StringNull()),
+		})
+
+		_, diags := fillElementNames(set, path.Root("accounts"), "account_id", "account_name", eligibilityAccountAttrTypes, func() (map[string]string, error) {
+			return accountNames, nil
+		})
+
+		if !diags.HasError() {
+			t.Fatal("expected an error diagnostic, got none")
+		}
+
+		withPath, ok := diags.Errors()[0].(interface{ Path() path.Path })
+		want := path.Root("accounts").AtSetValue(set.Elements()[0]).AtName("account_id")
+
+		if !ok || !withPath.Path().Equal(want) {
+			t.Errorf("got diagnostic %v, want an error for %s", diags.Errors()[0], want)
+		}
+	})
+}
+
+func TestValidateElementNames(t *testing.T) {
+	elemType := types.ObjectType{AttrTypes: eligibilityAccountAttrTypes}
+	account := func(id types.String, name types.String) attr.Value {
+		return types.ObjectValueMust(eligibilityAccountAttrTypes, map[string]attr.Value{"account_id": id, "account_name": name})
+	}
+	accountNames := func() (map[string]string, error) {
+		return map[string]string{"111111111111": "production"}, nil
+	}
+
+	testCases := map[string]struct {
+		elem     attr.Value
+		list     func() (map[string]string, error)
+		wantAttr string
+	}{
+		"matching name": {
+			elem: account(types.StringValue("111111111111"), types.StringValue("production")),
+			list: accountNames,
+		},
+		"mismatched name": {
+			elem:     account(types.StringValue("111111111111"), types.StringValue("staging")),
+			list:     accountNames,
+			wantAttr: "account_name",
+		},
+		"unknown id": {
+			elem:     account(types.StringValue("999999999999"), types.StringValue("production")),
+			list:     accountNames,
+			wantAttr: "account_id",
+		},
+		"unknown name is not checked": {
+			elem: account(types.StringValue("111111111111"), types.StringUnknown()),
+			list: func() (map[string]string, error) {
+				return nil, errors.New("unexpected lookup")
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			set := types.SetValueMust(elemType, []attr.Value{tc.elem})
+
+			diags := validateElementNames(set, path.Root("accounts"), "account_id", "account_name", "account", tc.list)
+
+			if tc.wantAttr == "" {
+				if diags.HasError() {
+					t.Fatalf("unexpected diagnostics: %v", diags)
+				}
+
+				return
+			}
+
+			if !diags.HasError() {
+				t.Fatal("expected an error diagnostic, got none")
+			}
+
+			withPath, ok := diags.Errors()[0].(interface{ Path() path.Path })
+			want := path.Root("accounts").AtSetValue(tc.elem).AtName(tc.wantAttr)
+
+			if !ok || !withPath.Path().Equal(want) {
+				t.Errorf("got diagnostic %v, want an error for %s", diags.Errors()[0], want)
+			}
+		})
+	}
+}
+
+func TestAWSTEAMClient_eligibilityLookup(t *testing.T) {
+	ctx := context.Background()
+
+	var mu sync.Mutex
+	calls := 0
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/oauth2/token", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"access_token":"test-token","expires_in":3600,"token_type":"Bearer"}`))
+	})
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		calls++
+		mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":{"getAccounts":[{"id":"123456789012","name":"production"}]}}`))
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	config := &awsteam.Config{
+		ClientId:      "test-client",
+		ClientSecret:  "test-secret",
+		GraphEndpoint: server.URL + "/graphql",
+		TokenEndpoint: server.URL + "/oauth2/token",
+	}
+
+	if err := config.Build(ctx); err != nil {
+		t.Fatalf("requesting token: %s", err)
+	}
+
+	client, err := config.NewClient(ctx)
+
+	if err != nil {
+		t.Fatalf("creating client: %s", err)
+	}
+
+	meta := &AWSTEAMClient{Client: client}
+
+	if meta.eligibilityLookup() != meta.eligibilityLookup() {
+		t.Fatal("expected resources to share the lookup")
+	}
+
+	var wg sync.WaitGroup
+
+	for range 10 {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			names, err := meta.eligibilityLookup().accountNames(ctx)
+
+			if err != nil || names["123456789012"] != "production" {
+				t.Errorf("got %v, %v", names, err)
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	if calls != 1 {
+		t.Errorf("got %d requests, want 1", calls)
+	}
+}
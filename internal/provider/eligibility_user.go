@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"reflect"
 
-	"github.com/YakDriver/regexache"
 	"github.com/aws/smithy-go/ptr"
 	"github.com/awsteam-contrib/terraform-provider-awsteam/internal/names"
 	"github.com/awsteam-contrib/terraform-provider-awsteam/internal/sdk/awsteam"
@@ -29,13 +28,17 @@ const (
 var _ resource.Resource = &EligibilityUserResource{}
 var _ resource.ResourceWithImportState = &EligibilityUserResource{}
 var _ resource.ResourceWithValidateConfig = &EligibilityUserResource{}
+var _ resource.ResourceWithModifyPlan = &EligibilityUserResource{}
 
 func NewEligibilityUserResource() resource.Resource {
 	return &EligibilityUserResource{}
 }
 
 type EligibilityUserResource struct {
-	client *awsteam.Client
+	client     *awsteam.Client
+	lookup     *eligibilityLookup
+	principals *identityCenterLookup
+	validate   bool
 }
 
 type EligibilityUserModel struct {
@@ -75,22 +78,23 @@ func (r *EligibilityUserResource) Schema(ctx context.Context, req resource.Schem
 				Required:            true,
 			},
 			"user_name": schema.StringAttribute{
-				MarkdownDescription: "Name of the AWS iam identity center user the eligibility policy will be applied to.",
-				Required:            true,
+				MarkdownDescription: "Name of the AWS iam identity center user the eligibility policy will be applied to. Either `user_name` or `user_id` must be provided; when only `user_id` is, the name is looked up in IAM Identity Center during plan.",
+				Optional:            true,
+				Computed:            true,
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 				Validators: []validator.String{
-					stringvalidator.RegexMatches(
-						regexache.MustCompile(`[\s\S]*`),
-						"value must be a valid aws user name.",
-					),
+					stringvalidator.LengthAtLeast(1),
 				},
 			},
 			"user_id": schema.StringAttribute{
-				MarkdownDescription: "Id of the AWS iam identity center user the eligibility policy will be applied to.",
-				Required:            true,
+				MarkdownDescription: "Id of the AWS iam identity center user the eligibility policy will be applied to. Either `user_name` or `user_id` must be provided; when only `user_name` is, the id is looked up in IAM Identity Center during plan. When both are provided, the plan fails if IAM Identity Center does not know the user or assigns them to different users.",
+				Optional:            true,
+				Computed:            true,
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
@@ -127,6 +131,76 @@ func (r *EligibilityUserResource) ValidateConfig(ctx context.Context, req resour
 			accountsAndOUsRequiredMessageDetail,
 		)
 	}
+
+	if config.UserName.IsNull() && config.UserId.IsNull() {
+		resp.Diagnostics.AddError(
+			"Missing User",
+			"Either user_name or user_id must be provided.",
+		)
+	}
+}
+
+func (r *EligibilityUserResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var config, plan EligibilityUserModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Values that are only known during apply are resolved by Create.
+	resolveUser := !config.UserName.IsUnknown() && !config.UserId.IsUnknown()
+
+	if resolveUser && !req.State.Raw.IsNull() {
+		var state EligibilityUserModel
+
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		// The user has not changed, the plan already holds the values from state.
+		if (config.UserName.IsNull() || config.UserName.Equal(state.UserName)) && (config.UserId.IsNull() || config.UserId.Equal(state.UserId)) {
+			resolveUser = false
+		}
+	}
+
+	if resolveUser {
+		name, id, diags := resolveIdCUser(ctx, r.principals, config.UserName, config.UserId, path.Root("user_name"), path.Root("user_id"))
+
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		plan.UserName = name
+		plan.UserId = id
+	}
+
+	// Names omitted in config are resolved again, so renames are not hidden by state.
+	accounts, ous, diags := fillEligibilityNames(ctx, r.lookup, config.Accounts, config.OUs)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Accounts = accounts
+	plan.OUs = ous
+
+	if r.validate {
+		resp.Diagnostics.Append(validateEligibilityNames(ctx, r.lookup, plan.Accounts, plan.OUs, plan.Permissions)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
 }
 
 func (r *EligibilityUserResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -134,18 +208,21 @@ func (r *EligibilityUserResource) Configure(ctx context.Context, req resource.Co
 		return
 	}
 
-	client, ok := req.ProviderData.(*awsteam.Client)
+	meta, ok := req.ProviderData.(*AWSTEAMClient)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *awsteam.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *provider.AWSTEAMClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = client
+	r.client = meta.Client
+	r.lookup = meta.eligibilityLookup()
+	r.principals = meta.identityCenterLookup()
+	r.validate = meta.ValidateEligibilities
 }
 
 func (r *EligibilityUserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -157,6 +234,28 @@ func (r *EligibilityUserResource) Create(ctx context.Context, req resource.Creat
 		return
 	}
 
+	if data.UserName.IsUnknown() || data.UserId.IsUnknown() {
+		name, id, diags := resolveIdCUser(ctx, r.principals, data.UserName, data.UserId, path.Root("user_name"), path.Root("user_id"))
+
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		data.UserName = name
+		data.UserId = id
+	}
+
+	filledAccounts, filledOUs, fillDiags := fillEligibilityNames(ctx, r.lookup, data.Accounts, data.OUs)
+
+	resp.Diagnostics.Append(fillDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Accounts = filledAccounts
+	data.OUs = filledOUs
+
 	var accounts []*EligibilityAccount
 	var ous []*EligibilityOU
 	var permissions []*EligibilityPermission
@@ -192,7 +291,7 @@ func (r *EligibilityUserResource) Create(ctx context.Context, req resource.Creat
 	out, err := r.client.CreateEligibility(ctx, in)
 
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create eligibility user, got error: %s", err))
+		resp.Diagnostics.Append(clientErrorDiagnostic("Unable to create eligibility user", err))
 		return
 	}
 
@@ -231,8 +330,14 @@ func (r *EligibilityUserResource) Read(ctx context.Context, req resource.ReadReq
 
 	out, err := r.client.GetEligibility(ctx, in)
 
+	if isNotFound(err) {
+		resp.Diagnostics.AddWarning("Read Error", "Received empty Eligibility. Removing from state.")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read eligibility user policy, got error: %s", err))
+		resp.Diagnostics.Append(clientErrorDiagnostic("Unable to read eligibility user policy", err))
 		return
 	}
 
@@ -286,6 +391,16 @@ func (r *EligibilityUserResource) Update(ctx context.Context, req resource.Updat
 	}
 
 	if updateRequired {
+		filledAccounts, filledOUs, fillDiags := fillEligibilityNames(ctx, r.lookup, plan.Accounts, plan.OUs)
+
+		resp.Diagnostics.Append(fillDiags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		plan.Accounts = filledAccounts
+		plan.OUs = filledOUs
+
 		var accounts []*EligibilityAccount
 		var ous []*EligibilityOU
 		var permissions []*EligibilityPermission
@@ -321,7 +436,7 @@ func (r *EligibilityUserResource) Update(ctx context.Context, req resource.Updat
 		out, err := r.client.UpdateEligibility(ctx, in)
 
 		if err != nil {
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update eligibility user, got error: %s", err))
+			resp.Diagnostics.Append(clientErrorDiagnostic("Unable to update eligibility user", err))
 			return
 		}
 
@@ -364,8 +479,8 @@ func (r *EligibilityUserResource) Delete(ctx context.Context, req resource.Delet
 
 	_, err := r.client.DeleteEligibility(ctx, in)
 
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete eligibility user, got error: %s", err))
+	if err != nil && !isAlreadyDeleted(err) {
+		resp.Diagnostics.Append(clientErrorDiagnostic("Unable to delete eligibility user", err))
 		return
 	}
 }
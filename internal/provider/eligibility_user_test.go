@@ -6,25 +6,24 @@ import (
 	"regexp"
 	"testing"
 
-	"github.com/brianvoe/gofakeit/v6"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
 func TestAccEligibilityUserResource_basic(t *testing.T) {
+	faker := testAccFaker(t)
 	ctx := context.Background()
 	resourceName := "awsteam_eligibility_user.test"
-	user1 := gofakeit.Email()
-	user2 := gofakeit.Email()
-	userId1 := gofakeit.UUID()
-	userId2 := gofakeit.UUID()
+	users := testAccIdentityCenterUsers(t, faker, 2)
+	user1, userId1 := users[0].Name, users[0].Id
+	user2, userId2 := users[1].Name, users[1].Id
 	approval1 := true
 	approval2 := false
-	duration := fmt.Sprint(gofakeit.Number(1, 10))
-	ticketNo := gofakeit.BS()
-	accountId := gofakeit.DigitN(12)
-	accountName := gofakeit.BS()
+	duration := fmt.Sprint(faker.Number(1, 10))
+	ticketNo := faker.BS()
+	accountId := faker.DigitN(12)
+	accountName := faker.BS()
 	ouId := "ou-cxt3-2782ty5g" // hard coded fake ou id
-	ouName := gofakeit.BS()
+	ouName := faker.BS()
 	permissionArn := "arn:aws:sso:::permissionSet/ssoins-4334d1f197f50907/ps-f5ge203d3d2428d3" // hard coded fake arn
 	permissionName := "elevated"
 
@@ -80,11 +79,12 @@ func TestAccEligibilityUserResource_basic(t *testing.T) {
 }
 
 func TestAccEligibilityUserResource_missingAccountsAndOUs(t *testing.T) {
-	user1 := gofakeit.Email()
-	userId1 := gofakeit.UUID()
+	faker := testAccFaker(t)
+	user := testAccIdentityCenterUsers(t, faker, 1)[0]
+	user1, userId1 := user.Name, user.Id
 	approval1 := true
-	duration := fmt.Sprint(gofakeit.Number(1, 10))
-	ticketNo := gofakeit.BS()
+	duration := fmt.Sprint(faker.Number(1, 10))
+	ticketNo := faker.BS()
 	permissionArn := "arn:aws:sso:::permissionSet/ssoins-4334d1f197f50907/ps-f5ge203d3d2428d3" // hard coded fake arn
 	permissionName := "elevated"
 
@@ -109,15 +109,16 @@ func TestAccEligibilityUserResource_missingAccountsAndOUs(t *testing.T) {
 }
 
 func TestAccEligibilityUserResource_missingAccounts(t *testing.T) {
+	faker := testAccFaker(t)
 	ctx := context.Background()
 	resourceName := "awsteam_eligibility_user.test"
-	user1 := gofakeit.Email()
-	userId1 := gofakeit.UUID()
+	user := testAccIdentityCenterUsers(t, faker, 1)[0]
+	user1, userId1 := user.Name, user.Id
 	approval1 := true
-	duration := fmt.Sprint(gofakeit.Number(1, 10))
-	ticketNo := gofakeit.BS()
+	duration := fmt.Sprint(faker.Number(1, 10))
+	ticketNo := faker.BS()
 	ouId := "ou-cxt3-2782ty5g" // hard coded fake ou id
-	ouName := gofakeit.BS()
+	ouName := faker.BS()
 	permissionArn := "arn:aws:sso:::permissionSet/ssoins-4334d1f197f50907/ps-f5ge203d3d2428d3" // hard coded fake arn
 	permissionName := "elevated"
 
@@ -160,15 +161,16 @@ func TestAccEligibilityUserResource_missingAccounts(t *testing.T) {
 }
 
 func TestAccEligibilityUserResource_missingOUs(t *testing.T) {
+	faker := testAccFaker(t)
 	ctx := context.Background()
 	resourceName := "awsteam_eligibility_user.test"
-	user1 := gofakeit.Email()
-	userId1 := gofakeit.UUID()
+	user := testAccIdentityCenterUsers(t, faker, 1)[0]
+	user1, userId1 := user.Name, user.Id
 	approval1 := true
-	duration := fmt.Sprint(gofakeit.Number(1, 10))
-	ticketNo := gofakeit.BS()
-	accountId := gofakeit.DigitN(12)
-	accountName := gofakeit.BS()
+	duration := fmt.Sprint(faker.Number(1, 10))
+	ticketNo := faker.BS()
+	accountId := faker.DigitN(12)
+	accountName := faker.BS()
 	permissionArn := "arn:aws:sso:::permissionSet/ssoins-4334d1f197f50907/ps-f5ge203d3d2428d3" // hard coded fake arn
 	permissionName := "elevated"
 
@@ -211,15 +213,16 @@ func TestAccEligibilityUserResource_missingOUs(t *testing.T) {
 }
 
 func TestAccEligibilityUserResource_emptyAccounts(t *testing.T) {
+	faker := testAccFaker(t)
 	ctx := context.Background()
 	resourceName := "awsteam_eligibility_user.test"
-	user1 := gofakeit.Email()
-	userId1 := gofakeit.UUID()
+	user := testAccIdentityCenterUsers(t, faker, 1)[0]
+	user1, userId1 := user.Name, user.Id
 	approval1 := true
-	duration := fmt.Sprint(gofakeit.Number(1, 10))
-	ticketNo := gofakeit.BS()
+	duration := fmt.Sprint(faker.Number(1, 10))
+	ticketNo := faker.BS()
 	ouId := "ou-cxt3-2782ty5g" // hard coded fake ou id
-	ouName := gofakeit.BS()
+	ouName := faker.BS()
 	permissionArn := "arn:aws:sso:::permissionSet/ssoins-4334d1f197f50907/ps-f5ge203d3d2428d3" // hard coded fake arn
 	permissionName := "elevated"
 
@@ -262,15 +265,16 @@ func TestAccEligibilityUserResource_emptyAccounts(t *testing.T) {
 }
 
 func TestAccEligibilityUserResource_emptyOUs(t *testing.T) {
+	faker := testAccFaker(t)
 	ctx := context.Background()
 	resourceName := "awsteam_eligibility_user.test"
-	user1 := gofakeit.Email()
-	userId1 := gofakeit.UUID()
+	user := testAccIdentityCenterUsers(t, faker, 1)[0]
+	user1, userId1 := user.Name, user.Id
 	approval1 := true
-	duration := fmt.Sprint(gofakeit.Number(1, 10))
-	ticketNo := gofakeit.BS()
-	accountId := gofakeit.DigitN(12)
-	accountName := gofakeit.BS()
+	duration := fmt.Sprint(faker.Number(1, 10))
+	ticketNo := faker.BS()
+	accountId := faker.DigitN(12)
+	accountName := faker.BS()
 	permissionArn := "arn:aws:sso:::permissionSet/ssoins-4334d1f197f50907/ps-f5ge203d3d2428d3" // hard coded fake arn
 	permissionName := "elevated"
 
@@ -313,17 +317,18 @@ func TestAccEligibilityUserResource_emptyOUs(t *testing.T) {
 }
 
 func TestAccEligibilityUserResource_disappears(t *testing.T) {
+	faker := testAccFaker(t)
 	ctx := context.Background()
 	resourceName := "awsteam_eligibility_user.test"
-	user1 := gofakeit.Email()
-	userId1 := gofakeit.UUID()
+	user := testAccIdentityCenterUsers(t, faker, 1)[0]
+	user1, userId1 := user.Name, user.Id
 	approval1 := true
-	duration := fmt.Sprint(gofakeit.Number(1, 10))
-	ticketNo := gofakeit.BS()
-	accountId := gofakeit.DigitN(12)
-	accountName := gofakeit.BS()
+	duration := fmt.Sprint(faker.Number(1, 10))
+	ticketNo := faker.BS()
+	accountId := faker.DigitN(12)
+	accountName := faker.BS()
 	ouId := "ou-cxt3-2782ty5g" // hard coded fake ou id
-	ouName := gofakeit.BS()
+	ouName := faker.BS()
 	permissionArn := "arn:aws:sso:::permissionSet/ssoins-4334d1f197f50907/ps-f5ge203d3d2428d3" // hard coded fake arn
 	permissionName := "elevated"
 
@@ -0,0 +1,248 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/aws/smithy-go/ptr"
+	"github.com/awsteam-contrib/terraform-provider-awsteam/internal/sdk/awsteam"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// findIdCGroupsByDisplayName returns the groups with the given display name.
+func findIdCGroupsByDisplayName(groups []*awsteam.IdCGroup, displayName string) []*awsteam.IdCGroup {
+	found := []*awsteam.IdCGroup{}
+
+	for _, group := range groups {
+		if group != nil && group.DisplayName != nil && *group.DisplayName == displayName {
+			found = append(found, group)
+		}
+	}
+
+	return found
+}
+
+// findIdCUsersByUserName returns the users with the given user name.
+func findIdCUsersByUserName(users []*awsteam.IdCUser, userName string) []*awsteam.IdCUser {
+	found := []*awsteam.IdCUser{}
+
+	for _, user := range users {
+		if user != nil && user.UserName != nil && *user.UserName == userName {
+			found = append(found, user)
+		}
+	}
+
+	return found
+}
+
+// findIdCUsersByEmail returns the users with the given email address. Email
+// addresses are compared without regard to case.
+func findIdCUsersByEmail(users []*awsteam.IdCUser, email string) []*awsteam.IdCUser {
+	found := []*awsteam.IdCUser{}
+
+	for _, user := range users {
+		if user != nil && user.Email != nil && strings.EqualFold(*user.Email, email) {
+			found = append(found, user)
+		}
+	}
+
+	return found
+}
+
+// identityCenterPrincipal is the id and name of an Identity Center group or
+// user, as used by the eligibility resources.
+type identityCenterPrincipal struct {
+	Id   string
+	Name string
+}
+
+// principalKind describes the principal resolved by resolvePrincipal, for use
+// in diagnostics.
+type principalKind struct {
+	// Singular noun, e.g. "group".
+	Noun string
+
+	// Singular noun for diagnostic summaries, e.g. "Group".
+	Title string
+
+	// The name attribute of the principal, e.g. "display name".
+	NameLabel string
+}
+
+var (
+	groupPrincipal = principalKind{Noun: "group", Title: "Group", NameLabel: "display name"}
+	userPrincipal  = principalKind{Noun: "user", Title: "User", NameLabel: "user name"}
+)
+
+// identityCenterLookup requests the groups and users of IAM Identity Center at
+// most once. It is shared by the resources of a provider configuration, which
+// plan concurrently.
+type identityCenterLookup struct {
+	client *awsteam.Client
+	mu     sync.Mutex
+	groups []identityCenterPrincipal
+	users  []identityCenterPrincipal
+}
+
+func newIdentityCenterLookup(client *awsteam.Client) *identityCenterLookup {
+	return &identityCenterLookup{client: client}
+}
+
+func (l *identityCenterLookup) groupPrincipals(ctx context.Context) ([]identityCenterPrincipal, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.groups != nil {
+		return l.groups, nil
+	}
+
+	out, err := l.client.GetIdCGroups(ctx, &awsteam.GetIdCGroupsInput{})
+
+	if err != nil {
+		return nil, err
+	}
+
+	l.groups = make([]identityCenterPrincipal, 0, len(out.Groups))
+
+	for _, group := range out.Groups {
+		if group != nil {
+			l.groups = append(l.groups, identityCenterPrincipal{Id: ptr.ToString(group.GroupId), Name: ptr.ToString(group.DisplayName)})
+		}
+	}
+
+	return l.groups, nil
+}
+
+func (l *identityCenterLookup) userPrincipals(ctx context.Context) ([]identityCenterPrincipal, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.users != nil {
+		return l.users, nil
+	}
+
+	out, err := l.client.GetIdCUsers(ctx, &awsteam.GetIdCUsersInput{})
+
+	if err != nil {
+		return nil, err
+	}
+
+	l.users = make([]identityCenterPrincipal, 0, len(out.Users))
+
+	for _, user := range out.Users {
+		if user != nil {
+			l.users = append(l.users, identityCenterPrincipal{Id: ptr.ToString(user.UserId), Name: ptr.ToString(user.UserName)})
+		}
+	}
+
+	return l.users, nil
+}
+
+// resolveIdCGroup completes the id and display name of an Identity Center
+// group from whichever of the two is known. See resolvePrincipal.
+func resolveIdCGroup(ctx context.Context, lookup *identityCenterLookup, name types.String, id types.String, namePath path.Path, idPath path.Path) (types.String, types.String, diag.Diagnostics) {
+	return resolvePrincipal(groupPrincipal, name, id, namePath, idPath, func() ([]identityCenterPrincipal, error) {
+		return lookup.groupPrincipals(ctx)
+	})
+}
+
+// resolveIdCUser completes the id and user name of an Identity Center user
+// from whichever of the two is known. See resolvePrincipal.
+func resolveIdCUser(ctx context.Context, lookup *identityCenterLookup, name types.String, id types.String, namePath path.Path, idPath path.Path) (types.String, types.String, diag.Diagnostics) {
+	return resolvePrincipal(userPrincipal, name, id, namePath, idPath, func() ([]identityCenterPrincipal, error) {
+		return lookup.userPrincipals(ctx)
+	})
+}
+
+// resolvePrincipal returns the name and id of a principal of which only one
+// may be known; null and unknown values are treated alike. The missing value
+// is looked up in the principals returned by list. When both are known they
+// are checked against each other: an error is reported if Identity Center
+// assigns the id or the name to a different principal, or does not know the
+// principal at all.
+func resolvePrincipal(kind principalKind, name types.String, id types.String, namePath path.Path, idPath path.Path, list func() ([]identityCenterPrincipal, error)) (types.String, types.String, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	nameKnown := !name.IsNull() && !name.IsUnknown()
+	idKnown := !id.IsNull() && !id.IsUnknown()
+
+	if !nameKnown && !idKnown {
+		return name, id, diags
+	}
+
+	principals, err := list()
+
+	if err != nil {
+		diags.Append(clientErrorDiagnostic(fmt.Sprintf("Unable to read identity center %ss", kind.Noun), err))
+		return name, id, diags
+	}
+
+	var byId *identityCenterPrincipal
+	var byName []identityCenterPrincipal
+
+	for i, principal := range principals {
+		if idKnown && principal.Id == id.ValueString() {
+			byId = &principals[i]
+		}
+
+		if nameKnown && principal.Name == name.ValueString() {
+			byName = append(byName, principal)
+		}
+	}
+
+	switch {
+	case nameKnown && idKnown:
+		if byId != nil && byId.Name != name.ValueString() {
+			diags.AddAttributeError(
+				namePath,
+				fmt.Sprintf("Identity Center %s Mismatch", kind.Title),
+				fmt.Sprintf("The %s with id %q has the %s %q, not %q.", kind.Noun, id.ValueString(), kind.NameLabel, byId.Name, name.ValueString()),
+			)
+		} else if byId == nil && len(byName) > 0 {
+			diags.AddAttributeError(
+				idPath,
+				fmt.Sprintf("Identity Center %s Mismatch", kind.Title),
+				fmt.Sprintf("The %s with the %s %q has the id %q, not %q.", kind.Noun, kind.NameLabel, name.ValueString(), byName[0].Id, id.ValueString()),
+			)
+		} else if byId == nil {
+			diags.AddAttributeError(
+				idPath,
+				fmt.Sprintf("Identity Center %s Not Found", kind.Title),
+				fmt.Sprintf("No %s with the id %q exists in IAM Identity Center.", kind.Noun, id.ValueString()),
+			)
+		}
+	case nameKnown:
+		switch len(byName) {
+		case 0:
+			diags.AddAttributeError(
+				namePath,
+				fmt.Sprintf("Identity Center %s Not Found", kind.Title),
+				fmt.Sprintf("No %s with the %s %q exists in IAM Identity Center.", kind.Noun, kind.NameLabel, name.ValueString()),
+			)
+		case 1:
+			id = types.StringValue(byName[0].Id)
+		default:
+			diags.AddAttributeError(
+				namePath,
+				fmt.Sprintf("Ambiguous Identity Center %s", kind.Title),
+				fmt.Sprintf("More than one %s has the %s %q. Provide the id instead.", kind.Noun, kind.NameLabel, name.ValueString()),
+			)
+		}
+	case idKnown:
+		if byId == nil {
+			diags.AddAttributeError(
+				idPath,
+				fmt.Sprintf("Identity Center %s Not Found", kind.Title),
+				fmt.Sprintf("No %s with the id %q exists in IAM Identity Center.", kind.Noun, id.ValueString()),
+			)
+		} else {
+			name = types.StringValue(byId.Name)
+		}
+	}
+
+	return name, id, diags
+}
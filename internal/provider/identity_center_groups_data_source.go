@@ -0,0 +1,186 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/awsteam-contrib/terraform-provider-awsteam/internal/sdk/awsteam"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	identityCenterGroupAttrTypes = map[string]attr.Type{
+		"id":           types.StringType,
+		"display_name": types.StringType,
+	}
+)
+
+var _ datasource.DataSource = &IdentityCenterGroupsDataSource{}
+
+func NewIdentityCenterGroupsDataSource() datasource.DataSource {
+	return &IdentityCenterGroupsDataSource{}
+}
+
+type IdentityCenterGroupsDataSource struct {
+	client *awsteam.Client
+}
+
+type IdentityCenterGroupsModel struct {
+	Id            types.String `tfsdk:"id"`
+	DisplayName   types.String `tfsdk:"display_name"`
+	Groups        types.List   `tfsdk:"groups"`
+	ByDisplayName types.Map    `tfsdk:"by_display_name"`
+}
+
+func (d *IdentityCenterGroupsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_identity_center_groups"
+}
+
+func identityCenterGroupAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "The Identity Center group id.",
+			Computed:            true,
+		},
+		"display_name": schema.StringAttribute{
+			MarkdownDescription: "Display name of the group.",
+			Computed:            true,
+		},
+	}
+}
+
+func (d *IdentityCenterGroupsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Provides a data source for the IAM Identity Center groups known to AWS TEAM.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identity Center Groups Identifier. This is a static value of `identity_center_groups`.",
+				Computed:            true,
+			},
+			"display_name": schema.StringAttribute{
+				MarkdownDescription: "Only return the group with this display name.",
+				Optional:            true,
+			},
+			"groups": schema.ListNestedAttribute{
+				MarkdownDescription: "A list of the groups in the order returned by AWS TEAM.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: identityCenterGroupAttributes(),
+				},
+			},
+			"by_display_name": schema.MapNestedAttribute{
+				MarkdownDescription: "The groups keyed by display name.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: identityCenterGroupAttributes(),
+				},
+			},
+		},
+	}
+}
+
+func (d *IdentityCenterGroupsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	meta, ok := req.ProviderData.(*AWSTEAMClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.AWSTEAMClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = meta.Client
+}
+
+func (d *IdentityCenterGroupsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data IdentityCenterGroupsModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in := &awsteam.GetIdCGroupsInput{}
+
+	out, err := d.client.GetIdCGroups(ctx, in)
+
+	if err != nil {
+		resp.Diagnostics.Append(clientErrorDiagnostic("Unable to read identity center groups", err))
+		return
+	}
+
+	resp.Diagnostics.Append(data.flatten(out)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "read identity center groups data source")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *IdentityCenterGroupsModel) flatten(out *awsteam.GetIdCGroupsOutput) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	groups := out.Groups
+
+	if !d.DisplayName.IsNull() {
+		groups = findIdCGroupsByDisplayName(groups, d.DisplayName.ValueString())
+	}
+
+	elemType := types.ObjectType{AttrTypes: identityCenterGroupAttrTypes}
+	elems := []attr.Value{}
+	byDisplayName := map[string]attr.Value{}
+
+	for _, group := range groups {
+		if group == nil {
+			continue
+		}
+
+		obj, objDiags := types.ObjectValue(identityCenterGroupAttrTypes, map[string]attr.Value{
+			"id":           types.StringPointerValue(group.GroupId),
+			"display_name": types.StringPointerValue(group.DisplayName),
+		})
+		diags.Append(objDiags...)
+
+		elems = append(elems, obj)
+
+		if group.DisplayName != nil {
+			byDisplayName[*group.DisplayName] = obj
+		}
+	}
+
+	if diags.HasError() {
+		return diags
+	}
+
+	list, listDiags := types.ListValue(elemType, elems)
+	diags.Append(listDiags...)
+
+	byDisplayNameMap, byDisplayNameDiags := types.MapValue(elemType, byDisplayName)
+	diags.Append(byDisplayNameDiags...)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	d.Id = types.StringValue("identity_center_groups")
+	d.Groups = list
+	d.ByDisplayName = byDisplayNameMap
+
+	return diags
+}
@@ -0,0 +1,91 @@
+package provider
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/aws/smithy-go/ptr"
+	"github.com/awsteam-contrib/terraform-provider-awsteam/internal/sdk/awsteam"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccIdentityCenterGroupsDataSource_basic(t *testing.T) {
+	dataSourceName := "data.awsteam_identity_center_groups.test"
+
+	// This environment variable should be set to the display name of a group expected to be returned by the API.
+	expectedNameVar := "AWSTEAM_TESTS_EXPECTED_GROUP_NAME"
+	expectedName := os.Getenv(expectedNameVar)
+	if expectedName == "" {
+		t.Skipf("Skipping Identity Center Groups Tests, Environment variable %s is not set.", expectedNameVar)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityCenterGroupsDataSourceConfig(expectedName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "id", "identity_center_groups"),
+					resource.TestCheckResourceAttr(dataSourceName, "groups.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "groups.0.display_name", expectedName),
+					resource.TestCheckResourceAttrSet(dataSourceName, "groups.0.id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccIdentityCenterGroupsDataSourceConfig(displayName string) string {
+	return `
+data "awsteam_identity_center_groups" "test" {
+  display_name = "` + displayName + `"
+}`
+}
+
+func TestIdentityCenterGroupsModel_flatten(t *testing.T) {
+	ctx := context.Background()
+	out := &awsteam.GetIdCGroupsOutput{
+		Groups: []*awsteam.IdCGroup{
+			{GroupId: ptr.String("d78686b5-bb78-471c-8b2f-817e70e3158b"), DisplayName: ptr.String("Admins")},
+			{GroupId: ptr.String("a1b2c3d4-0000-0000-0000-000000000002"), DisplayName: ptr.String("Auditors")},
+		},
+	}
+
+	type group struct {
+		Id          string `tfsdk:"id"`
+		DisplayName string `tfsdk:"display_name"`
+	}
+
+	data := IdentityCenterGroupsModel{DisplayName: types.StringNull()}
+
+	if diags := data.flatten(out); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	var byDisplayName map[string]group
+	if diags := data.ByDisplayName.ElementsAs(ctx, &byDisplayName, false); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if got := byDisplayName["Auditors"].Id; got != "a1b2c3d4-0000-0000-0000-000000000002" {
+		t.Errorf("got id %q for Auditors", got)
+	}
+
+	data = IdentityCenterGroupsModel{DisplayName: types.StringValue("Admins")}
+
+	if diags := data.flatten(out); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	var groups []group
+	if diags := data.Groups.ElementsAs(ctx, &groups, false); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if len(groups) != 1 || groups[0].Id != "d78686b5-bb78-471c-8b2f-817e70e3158b" {
+		t.Errorf("got %+v for display name Admins", groups)
+	}
+}
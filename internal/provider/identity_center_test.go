@@ -0,0 +1,220 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/aws/smithy-go/ptr"
+	"github.com/awsteam-contrib/terraform-provider-awsteam/internal/awsteamtest"
+	"github.com/awsteam-contrib/terraform-provider-awsteam/internal/sdk/awsteam"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestResolvePrincipal(t *testing.T) {
+	principals := []identityCenterPrincipal{
+		{Id: "d78686b5-0000-0000-0000-000000000001", Name: "Admins"},
+		{Id: "d78686b5-0000-0000-0000-000000000002", Name: "Auditors"},
+		{Id: "d78686b5-0000-0000-0000-000000000003", Name: "Twins"},
+		{Id: "d78686b5-0000-0000-0000-000000000004", Name: "Twins"},
+	}
+
+	testCases := map[string]struct {
+		name      types.String
+		id        types.String
+		listErr   error
+		wantName  types.String
+		wantId    types.String
+		wantError bool
+		wantPath  path.Path
+		wantCalls int
+	}{
+		"name only": {
+			name:      types.StringValue("Auditors"),
+			id:        types.StringNull(),
+			wantName:  types.StringValue("Auditors"),
+			wantId:    types.StringValue("d78686b5-0000-0000-0000-000000000002"),
+			wantCalls: 1,
+		},
+		"id only": {
+			name:      types.StringUnknown(),
+			id:        types.StringValue("d78686b5-0000-0000-0000-000000000001"),
+			wantName:  types.StringValue("Admins"),
+			wantId:    types.StringValue("d78686b5-0000-0000-0000-000000000001"),
+			wantCalls: 1,
+		},
+		"neither": {
+			name:     types.StringNull(),
+			id:       types.StringUnknown(),
+			wantName: types.StringNull(),
+			wantId:   types.StringUnknown(),
+		},
+		"both matching": {
+			name:      types.StringValue("Admins"),
+			id:        types.StringValue("d78686b5-0000-0000-0000-000000000001"),
+			wantName:  types.StringValue("Admins"),
+			wantId:    types.StringValue("d78686b5-0000-0000-0000-000000000001"),
+			wantCalls: 1,
+		},
+		"both unknown to identity center": {
+			name:      types.StringValue("Ghosts"),
+			id:        types.StringValue("d78686b5-0000-0000-0000-000000000009"),
+			wantError: true,
+			wantPath:  path.Root("id"),
+			wantCalls: 1,
+		},
+		"id belongs to another name": {
+			name:      types.StringValue("Auditors"),
+			id:        types.StringValue("d78686b5-0000-0000-0000-000000000001"),
+			wantError: true,
+			wantPath:  path.Root("name"),
+			wantCalls: 1,
+		},
+		"name belongs to another id": {
+			name:      types.StringValue("Auditors"),
+			id:        types.StringValue("d78686b5-0000-0000-0000-000000000009"),
+			wantError: true,
+			wantPath:  path.Root("id"),
+			wantCalls: 1,
+		},
+		"unknown name": {
+			name:      types.StringValue("Ghosts"),
+			id:        types.StringNull(),
+			wantError: true,
+			wantPath:  path.Root("name"),
+			wantCalls: 1,
+		},
+		"unknown id": {
+			name:      types.StringNull(),
+			id:        types.StringValue("d78686b5-0000-0000-0000-000000000009"),
+			wantError: true,
+			wantPath:  path.Root("id"),
+			wantCalls: 1,
+		},
+		"ambiguous name": {
+			name:      types.StringValue("Twins"),
+			id:        types.StringNull(),
+			wantError: true,
+			wantPath:  path.Root("name"),
+			wantCalls: 1,
+		},
+		"lookup fails for a missing value": {
+			name:      types.StringValue("Admins"),
+			id:        types.StringNull(),
+			listErr:   errors.New("boom"),
+			wantError: true,
+			wantCalls: 1,
+		},
+		"lookup fails when both are given": {
+			name:      types.StringValue("Admins"),
+			id:        types.StringValue("d78686b5-0000-0000-0000-000000000001"),
+			listErr:   errors.New("boom"),
+			wantError: true,
+			wantCalls: 1,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			calls := 0
+			list := func() ([]identityCenterPrincipal, error) {
+				calls++
+				return principals, tc.listErr
+			}
+
+			gotName, gotId, diags := resolvePrincipal(groupPrincipal, tc.name, tc.id, path.Root("name"), path.Root("id"), list)
+
+			if calls != tc.wantCalls {
+				t.Errorf("got %d lookups, want %d", calls, tc.wantCalls)
+			}
+
+			if diags.HasError() != tc.wantError {
+				t.Fatalf("got diagnostics %v, want error: %t", diags, tc.wantError)
+			}
+
+			if diags.WarningsCount() > 0 {
+				t.Errorf("got unexpected warnings %v", diags)
+			}
+
+			if tc.wantError {
+				if len(tc.wantPath.Steps()) > 0 {
+					withPath, ok := diags.Errors()[0].(interface{ Path() path.Path })
+
+					if !ok || !withPath.Path().Equal(tc.wantPath) {
+						t.Errorf("got diagnostic %v, want an error for %s", diags.Errors()[0], tc.wantPath)
+					}
+				}
+
+				return
+			}
+
+			if !gotName.Equal(tc.wantName) || !gotId.Equal(tc.wantId) {
+				t.Errorf("got %s/%s, want %s/%s", gotName, gotId, tc.wantName, tc.wantId)
+			}
+		})
+	}
+}
+
+func TestAWSTEAMClient_identityCenterLookup(t *testing.T) {
+	ctx := context.Background()
+
+	server := awsteamtest.NewServer()
+	defer server.Close()
+
+	server.AddUsers(&awsteam.IdCUser{UserId: ptr.String("d78686b5-0000-0000-0000-000000000001"), UserName: ptr.String("jane.doe")})
+
+	var mu sync.Mutex
+	calls := 0
+
+	config := server.Config()
+	config.APIOptions = append(config.APIOptions, func(s *awsteam.MiddlewareStack) error {
+		return s.Add(awsteam.MiddlewareFunc("Count", func(ctx context.Context, in *awsteam.OperationInput, next awsteam.Handler) (*awsteam.OperationOutput, error) {
+			mu.Lock()
+			calls++
+			mu.Unlock()
+
+			return next.Handle(ctx, in)
+		}), awsteam.Before)
+	})
+
+	if err := config.Build(ctx); err != nil {
+		t.Fatalf("requesting token: %s", err)
+	}
+
+	client, err := config.NewClient(ctx)
+
+	if err != nil {
+		t.Fatalf("creating client: %s", err)
+	}
+
+	meta := &AWSTEAMClient{Client: client}
+
+	if meta.identityCenterLookup() != meta.identityCenterLookup() {
+		t.Fatal("expected resources to share the lookup")
+	}
+
+	var wg sync.WaitGroup
+
+	for range 10 {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			_, id, diags := resolveIdCUser(ctx, meta.identityCenterLookup(), types.StringValue("jane.doe"), types.StringNull(), path.Root("user_name"), path.Root("user_id"))
+
+			if diags.HasError() || id.ValueString() != "d78686b5-0000-0000-0000-000000000001" {
+				t.Errorf("got %s, %v", id, diags)
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	if calls != 1 {
+		t.Errorf("got %d requests, want 1", calls)
+	}
+}
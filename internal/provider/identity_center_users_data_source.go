@@ -0,0 +1,207 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/awsteam-contrib/terraform-provider-awsteam/internal/sdk/awsteam"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	identityCenterUserAttrTypes = map[string]attr.Type{
+		"id":        types.StringType,
+		"user_name": types.StringType,
+		"email":     types.StringType,
+	}
+)
+
+var _ datasource.DataSource = &IdentityCenterUsersDataSource{}
+
+func NewIdentityCenterUsersDataSource() datasource.DataSource {
+	return &IdentityCenterUsersDataSource{}
+}
+
+type IdentityCenterUsersDataSource struct {
+	client *awsteam.Client
+}
+
+type IdentityCenterUsersModel struct {
+	Id         types.String `tfsdk:"id"`
+	UserName   types.String `tfsdk:"user_name"`
+	Email      types.String `tfsdk:"email"`
+	Users      types.List   `tfsdk:"users"`
+	ByUserName types.Map    `tfsdk:"by_user_name"`
+}
+
+func (d *IdentityCenterUsersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_identity_center_users"
+}
+
+func identityCenterUserAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "The Identity Center user id.",
+			Computed:            true,
+		},
+		"user_name": schema.StringAttribute{
+			MarkdownDescription: "User name of the user.",
+			Computed:            true,
+		},
+		"email": schema.StringAttribute{
+			MarkdownDescription: "Email address of the user.",
+			Computed:            true,
+		},
+	}
+}
+
+func (d *IdentityCenterUsersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Provides a data source for the IAM Identity Center users known to AWS TEAM.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identity Center Users Identifier. This is a static value of `identity_center_users`.",
+				Computed:            true,
+			},
+			"user_name": schema.StringAttribute{
+				MarkdownDescription: "Only return the user with this user name.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("email")),
+				},
+			},
+			"email": schema.StringAttribute{
+				MarkdownDescription: "Only return users with this email address. The comparison is not case sensitive.",
+				Optional:            true,
+			},
+			"users": schema.ListNestedAttribute{
+				MarkdownDescription: "A list of the users in the order returned by AWS TEAM.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: identityCenterUserAttributes(),
+				},
+			},
+			"by_user_name": schema.MapNestedAttribute{
+				MarkdownDescription: "The users keyed by user name.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: identityCenterUserAttributes(),
+				},
+			},
+		},
+	}
+}
+
+func (d *IdentityCenterUsersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	meta, ok := req.ProviderData.(*AWSTEAMClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.AWSTEAMClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = meta.Client
+}
+
+func (d *IdentityCenterUsersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data IdentityCenterUsersModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in := &awsteam.GetIdCUsersInput{}
+
+	out, err := d.client.GetIdCUsers(ctx, in)
+
+	if err != nil {
+		resp.Diagnostics.Append(clientErrorDiagnostic("Unable to read identity center users", err))
+		return
+	}
+
+	resp.Diagnostics.Append(data.flatten(out)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "read identity center users data source")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *IdentityCenterUsersModel) flatten(out *awsteam.GetIdCUsersOutput) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	users := out.Users
+
+	if !d.UserName.IsNull() {
+		users = findIdCUsersByUserName(users, d.UserName.ValueString())
+	}
+
+	if !d.Email.IsNull() {
+		users = findIdCUsersByEmail(users, d.Email.ValueString())
+	}
+
+	elemType := types.ObjectType{AttrTypes: identityCenterUserAttrTypes}
+	elems := []attr.Value{}
+	byUserName := map[string]attr.Value{}
+
+	for _, user := range users {
+		if user == nil {
+			continue
+		}
+
+		obj, objDiags := types.ObjectValue(identityCenterUserAttrTypes, map[string]attr.Value{
+			"id":        types.StringPointerValue(user.UserId),
+			"user_name": types.StringPointerValue(user.UserName),
+			"email":     types.StringPointerValue(user.Email),
+		})
+		diags.Append(objDiags...)
+
+		elems = append(elems, obj)
+
+		if user.UserName != nil {
+			byUserName[*user.UserName] = obj
+		}
+	}
+
+	if diags.HasError() {
+		return diags
+	}
+
+	list, listDiags := types.ListValue(elemType, elems)
+	diags.Append(listDiags...)
+
+	byUserNameMap, byUserNameDiags := types.MapValue(elemType, byUserName)
+	diags.Append(byUserNameDiags...)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	d.Id = types.StringValue("identity_center_users")
+	d.Users = list
+	d.ByUserName = byUserNameMap
+
+	return diags
+}
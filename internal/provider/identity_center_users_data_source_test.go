@@ -0,0 +1,128 @@
+package provider
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/aws/smithy-go/ptr"
+	"github.com/awsteam-contrib/terraform-provider-awsteam/internal/sdk/awsteam"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccIdentityCenterUsersDataSource_basic(t *testing.T) {
+	dataSourceName := "data.awsteam_identity_center_users.test"
+
+	// This environment variable should be set to the user name of a user expected to be returned by the API.
+	expectedUserNameVar := "AWSTEAM_TESTS_EXPECTED_USER_NAME"
+	expectedUserName := os.Getenv(expectedUserNameVar)
+	if expectedUserName == "" {
+		t.Skipf("Skipping Identity Center Users Tests, Environment variable %s is not set.", expectedUserNameVar)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityCenterUsersDataSourceConfig(expectedUserName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "id", "identity_center_users"),
+					resource.TestCheckResourceAttr(dataSourceName, "users.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "users.0.user_name", expectedUserName),
+					resource.TestCheckResourceAttrSet(dataSourceName, "users.0.id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccIdentityCenterUsersDataSourceConfig(userName string) string {
+	return `
+data "awsteam_identity_center_users" "test" {
+  user_name = "` + userName + `"
+}`
+}
+
+func TestIdentityCenterUsersModel_flatten(t *testing.T) {
+	ctx := context.Background()
+	out := &awsteam.GetIdCUsersOutput{
+		Users: []*awsteam.IdCUser{
+			{UserId: ptr.String("90676d8b-0000-0000-0000-000000000001"), UserName: ptr.String("jane"), Email: ptr.String("Jane.Doe@contoso.com")},
+			{UserId: ptr.String("90676d8b-0000-0000-0000-000000000002"), UserName: ptr.String("john"), Email: ptr.String("john.doe@contoso.com")},
+		},
+	}
+
+	testCases := map[string]struct {
+		userName types.String
+		email    types.String
+		want     []string
+	}{
+		"all": {
+			userName: types.StringNull(),
+			email:    types.StringNull(),
+			want:     []string{"90676d8b-0000-0000-0000-000000000001", "90676d8b-0000-0000-0000-000000000002"},
+		},
+		"user name": {
+			userName: types.StringValue("john"),
+			email:    types.StringNull(),
+			want:     []string{"90676d8b-0000-0000-0000-000000000002"},
+		},
+		"email": {
+			userName: types.StringNull(),
+			email:    types.StringValue("jane.doe@contoso.com"),
+			want:     []string{"90676d8b-0000-0000-0000-000000000001"},
+		},
+		"no match": {
+			userName: types.StringValue("nobody"),
+			email:    types.StringNull(),
+			want:     nil,
+		},
+	}
+
+	type user struct {
+		Id       string `tfsdk:"id"`
+		UserName string `tfsdk:"user_name"`
+		Email    string `tfsdk:"email"`
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			data := IdentityCenterUsersModel{UserName: tc.userName, Email: tc.email}
+
+			if diags := data.flatten(out); diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			var users []user
+			if diags := data.Users.ElementsAs(ctx, &users, false); diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			var ids []string
+			for _, u := range users {
+				ids = append(ids, u.Id)
+			}
+
+			if len(ids) != len(tc.want) {
+				t.Fatalf("got ids %v, want %v", ids, tc.want)
+			}
+
+			for i := range ids {
+				if ids[i] != tc.want[i] {
+					t.Errorf("got ids %v, want %v", ids, tc.want)
+				}
+			}
+
+			var byUserName map[string]user
+			if diags := data.ByUserName.ElementsAs(ctx, &byUserName, false); diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			if len(byUserName) != len(tc.want) {
+				t.Errorf("got %d map entries, want %d", len(byUserName), len(tc.want))
+			}
+		})
+	}
+}
@@ -0,0 +1,264 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/smithy-go/ptr"
+	"github.com/awsteam-contrib/terraform-provider-awsteam/internal/sdk/awsteam"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	organizationalUnitAttrTypes = map[string]attr.Type{
+		"id":        types.StringType,
+		"name":      types.StringType,
+		"arn":       types.StringType,
+		"parent_id": types.StringType,
+		"path":      types.StringType,
+	}
+)
+
+var _ datasource.DataSource = &OrganizationalUnitsDataSource{}
+
+func NewOrganizationalUnitsDataSource() datasource.DataSource {
+	return &OrganizationalUnitsDataSource{}
+}
+
+type OrganizationalUnitsDataSource struct {
+	client *awsteam.Client
+}
+
+type OrganizationalUnitsModel struct {
+	Id                  types.String `tfsdk:"id"`
+	RootId              types.String `tfsdk:"root_id"`
+	OrganizationalUnits types.List   `tfsdk:"organizational_units"`
+	ByName              types.Map    `tfsdk:"by_name"`
+	ByPath              types.Map    `tfsdk:"by_path"`
+}
+
+func (d *OrganizationalUnitsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organizational_units"
+}
+
+func organizationalUnitAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "The id of the organizational unit, or of the root.",
+			Computed:            true,
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "Name of the organizational unit.",
+			Computed:            true,
+		},
+		"arn": schema.StringAttribute{
+			MarkdownDescription: "ARN of the organizational unit.",
+			Computed:            true,
+		},
+		"parent_id": schema.StringAttribute{
+			MarkdownDescription: "The id of the parent organizational unit or root. Not set for the root.",
+			Computed:            true,
+		},
+		"path": schema.StringAttribute{
+			MarkdownDescription: "The names of the organizational units from the root down to this one, separated by `/`, for example `/Workloads/Prod`. The path of the root is `/`.",
+			Computed:            true,
+		},
+	}
+}
+
+func (d *OrganizationalUnitsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Provides a data source for the organizational units of the AWS Organization known to AWS TEAM.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Organizational Units Identifier. This is a static value of `organizational_units` as it contains all organizational units.",
+				Computed:            true,
+			},
+			"root_id": schema.StringAttribute{
+				MarkdownDescription: "The id of the root of the organization. Not set when TEAM returns the organizational units without their root.",
+				Computed:            true,
+			},
+			"organizational_units": schema.ListNestedAttribute{
+				MarkdownDescription: "A list of the root and all organizational units, with parents listed before their children.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: organizationalUnitAttributes(),
+				},
+			},
+			"by_name": schema.MapNestedAttribute{
+				MarkdownDescription: "The organizational units keyed by name. Names used by more than one organizational unit are left out; use `by_path` to look these up.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: organizationalUnitAttributes(),
+				},
+			},
+			"by_path": schema.MapNestedAttribute{
+				MarkdownDescription: "The organizational units keyed by path, for example `/Workloads/Prod`.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: organizationalUnitAttributes(),
+				},
+			},
+		},
+	}
+}
+
+func (d *OrganizationalUnitsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	meta, ok := req.ProviderData.(*AWSTEAMClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.AWSTEAMClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = meta.Client
+}
+
+func (d *OrganizationalUnitsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data OrganizationalUnitsModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in := &awsteam.GetOUsInput{}
+
+	out, err := d.client.GetOUs(ctx, in)
+
+	if err != nil {
+		resp.Diagnostics.Append(clientErrorDiagnostic("Unable to read organizational units", err))
+		return
+	}
+
+	resp.Diagnostics.Append(data.flatten(out)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "read organizational units data source")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *OrganizationalUnitsModel) flatten(out *awsteam.GetOUsOutput) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	elemType := types.ObjectType{AttrTypes: organizationalUnitAttrTypes}
+	elems := []attr.Value{}
+	byName := map[string]attr.Value{}
+	byPath := map[string]attr.Value{}
+	nameCount := map[string]int{}
+
+	root := organizationRoot(out.Root)
+
+	root.Walk(func(ou *awsteam.OU, parents []*awsteam.OU) bool {
+		// A root without an id only groups the OUs returned by TEAM.
+		if ou.Id == nil {
+			return true
+		}
+
+		path := organizationalUnitPath(ou, parents)
+
+		var parentId *string
+
+		if len(parents) > 0 {
+			parentId = parents[len(parents)-1].Id
+		}
+
+		obj, objDiags := types.ObjectValue(organizationalUnitAttrTypes, map[string]attr.Value{
+			"id":        types.StringPointerValue(ou.Id),
+			"name":      types.StringPointerValue(ou.Name),
+			"arn":       types.StringPointerValue(ou.Arn),
+			"parent_id": types.StringPointerValue(parentId),
+			"path":      types.StringValue(path),
+		})
+		diags.Append(objDiags...)
+
+		elems = append(elems, obj)
+		byPath[path] = obj
+
+		if ou.Name != nil {
+			nameCount[*ou.Name]++
+			byName[*ou.Name] = obj
+		}
+
+		return true
+	})
+
+	for name, count := range nameCount {
+		if count > 1 {
+			delete(byName, name)
+		}
+	}
+
+	if diags.HasError() {
+		return diags
+	}
+
+	list, listDiags := types.ListValue(elemType, elems)
+	diags.Append(listDiags...)
+
+	byNameMap, byNameDiags := types.MapValue(elemType, byName)
+	diags.Append(byNameDiags...)
+
+	byPathMap, byPathDiags := types.MapValue(elemType, byPath)
+	diags.Append(byPathDiags...)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	d.Id = types.StringValue("organizational_units")
+	d.RootId = types.StringPointerValue(root.Id)
+	d.OrganizationalUnits = list
+	d.ByName = byNameMap
+	d.ByPath = byPathMap
+
+	return diags
+}
+
+// organizationRoot returns the root of the organization in the tree decoded
+// from TEAM. When TEAM returns a list holding the root, the tree starts with a
+// node without an id grouping the list, and the root is its only child. Any
+// other tree is returned as it is.
+func organizationRoot(root *awsteam.OU) *awsteam.OU {
+	if root.Id == nil && len(root.Children) == 1 && strings.HasPrefix(ptr.ToString(root.Children[0].Id), "r-") {
+		return &root.Children[0]
+	}
+
+	return root
+}
+
+// organizationalUnitPath returns the names of the OUs below the root down to
+// ou, separated by slashes. The root itself is not named in the path.
+func organizationalUnitPath(ou *awsteam.OU, parents []*awsteam.OU) string {
+	if len(parents) == 0 {
+		return "/"
+	}
+
+	names := []string{}
+
+	for _, parent := range parents[1:] {
+		names = append(names, ptr.ToString(parent.Name))
+	}
+
+	return "/" + strings.Join(append(names, ptr.ToString(ou.Name)), "/")
+}
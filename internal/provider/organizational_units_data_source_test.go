@@ -0,0 +1,236 @@
+package provider
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/aws/smithy-go/ptr"
+	"github.com/awsteam-contrib/terraform-provider-awsteam/internal/sdk/awsteam"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccOrganizationalUnitsDataSource_basic(t *testing.T) {
+	dataSourceName := "data.awsteam_organizational_units.test"
+
+	// This environment variable should be set to the id of an OU expected to be returned by the API.
+	expectedOUIdVar := "AWSTEAM_TESTS_EXPECTED_OU_ID"
+	expectedOUId := os.Getenv(expectedOUIdVar)
+	if expectedOUId == "" {
+		t.Skipf("Skipping Organizational Units Tests, Environment variable %s is not set.", expectedOUIdVar)
+	}
+
+	// This environment variable should be set to the name of the OU id provided.
+	expectedOUNameVar := "AWSTEAM_TESTS_EXPECTED_OU_NAME"
+	expectedOUName := os.Getenv(expectedOUNameVar)
+	if expectedOUName == "" {
+		t.Skipf("Skipping Organizational Units Tests, Environment variable %s is not set.", expectedOUNameVar)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOrganizationalUnitsDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "id", "organizational_units"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "root_id"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "organizational_units.*",
+						map[string]string{
+							"id":   expectedOUId,
+							"name": expectedOUName,
+						}),
+				),
+			},
+		},
+	})
+}
+
+func testAccOrganizationalUnitsDataSourceConfig() string {
+	return `data "awsteam_organizational_units" "test" {}`
+}
+
+func TestOrganizationalUnitsModel_flatten(t *testing.T) {
+	ctx := context.Background()
+	out := &awsteam.GetOUsOutput{
+		Root: &awsteam.OU{
+			Id:   ptr.String("r-abcd"),
+			Name: ptr.String("Root"),
+			Children: []awsteam.OU{
+				{
+					Id:   ptr.String("ou-abcd-11111111"),
+					Name: ptr.String("Workloads"),
+					Arn:  ptr.String("arn:aws:organizations::111111111111:ou/o-example/ou-abcd-11111111"),
+					Children: []awsteam.OU{
+						{Id: ptr.String("ou-abcd-22222222"), Name: ptr.String("Dev")},
+					},
+				},
+				{
+					Id:   ptr.String("ou-abcd-33333333"),
+					Name: ptr.String("Sandbox"),
+					Children: []awsteam.OU{
+						{Id: ptr.String("ou-abcd-44444444"), Name: ptr.String("Dev")},
+					},
+				},
+			},
+		},
+	}
+
+	var data OrganizationalUnitsModel
+
+	if diags := data.flatten(out); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if got := data.RootId.ValueString(); got != "r-abcd" {
+		t.Errorf("got root id %q", got)
+	}
+
+	type ou struct {
+		Id       string  `tfsdk:"id"`
+		Name     string  `tfsdk:"name"`
+		Arn      *string `tfsdk:"arn"`
+		ParentId *string `tfsdk:"parent_id"`
+		Path     string  `tfsdk:"path"`
+	}
+
+	var list []ou
+	if diags := data.OrganizationalUnits.ElementsAs(ctx, &list, false); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	wantPaths := map[string]string{
+		"r-abcd":           "/",
+		"ou-abcd-11111111": "/Workloads",
+		"ou-abcd-22222222": "/Workloads/Dev",
+		"ou-abcd-33333333": "/Sandbox",
+		"ou-abcd-44444444": "/Sandbox/Dev",
+	}
+
+	if len(list) != len(wantPaths) {
+		t.Fatalf("got %d organizational units, want %d", len(list), len(wantPaths))
+	}
+
+	for _, ou := range list {
+		if ou.Path != wantPaths[ou.Id] {
+			t.Errorf("got path %q for %s, want %q", ou.Path, ou.Id, wantPaths[ou.Id])
+		}
+	}
+
+	if list[0].ParentId != nil {
+		t.Errorf("root has parent id %q", *list[0].ParentId)
+	}
+
+	if got := ptr.ToString(list[2].ParentId); got != "ou-abcd-11111111" {
+		t.Errorf("got parent id %q for %s", got, list[2].Id)
+	}
+
+	var byName map[string]ou
+	if diags := data.ByName.ElementsAs(ctx, &byName, false); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if _, ok := byName["Dev"]; ok {
+		t.Error("by_name contains the duplicate name Dev")
+	}
+
+	if got := byName["Workloads"]; got.Id != "ou-abcd-11111111" || ptr.ToString(got.Arn) == "" {
+		t.Errorf("got %+v for Workloads", got)
+	}
+
+	var byPath map[string]ou
+	if diags := data.ByPath.ElementsAs(ctx, &byPath, false); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if got := byPath["/Sandbox/Dev"].Id; got != "ou-abcd-44444444" {
+		t.Errorf("got id %q for /Sandbox/Dev", got)
+	}
+}
+
+func TestOrganizationalUnitsModel_flattenList(t *testing.T) {
+	ctx := context.Background()
+
+	workloads := awsteam.OU{
+		Id:       ptr.String("ou-abcd-11111111"),
+		Name:     ptr.String("Workloads"),
+		Children: []awsteam.OU{{Id: ptr.String("ou-abcd-22222222"), Name: ptr.String("Dev")}},
+	}
+
+	// Trees as decoded from a list-shaped response, with a root without an id
+	// grouping the list. Parent ids are empty when not set.
+	tests := []struct {
+		name       string
+		root       *awsteam.OU
+		wantRootId string
+		want       map[string][2]string
+	}{
+		{
+			name: "root",
+			root: &awsteam.OU{Children: []awsteam.OU{
+				{Id: ptr.String("r-abcd"), Name: ptr.String("Root"), Children: []awsteam.OU{workloads}},
+			}},
+			wantRootId: "r-abcd",
+			want: map[string][2]string{
+				"r-abcd":           {"", "/"},
+				"ou-abcd-11111111": {"r-abcd", "/Workloads"},
+				"ou-abcd-22222222": {"ou-abcd-11111111", "/Workloads/Dev"},
+			},
+		},
+		{
+			name: "organizational units",
+			root: &awsteam.OU{Children: []awsteam.OU{
+				workloads,
+				{Id: ptr.String("ou-abcd-33333333"), Name: ptr.String("Sandbox")},
+			}},
+			want: map[string][2]string{
+				"ou-abcd-11111111": {"", "/Workloads"},
+				"ou-abcd-22222222": {"ou-abcd-11111111", "/Workloads/Dev"},
+				"ou-abcd-33333333": {"", "/Sandbox"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var data OrganizationalUnitsModel
+
+			if diags := data.flatten(&awsteam.GetOUsOutput{Root: tt.root}); diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			if got := data.RootId.ValueString(); got != tt.wantRootId {
+				t.Errorf("got root id %q, want %q", got, tt.wantRootId)
+			}
+
+			var list []struct {
+				Id       string  `tfsdk:"id"`
+				Name     string  `tfsdk:"name"`
+				Arn      *string `tfsdk:"arn"`
+				ParentId *string `tfsdk:"parent_id"`
+				Path     string  `tfsdk:"path"`
+			}
+
+			if diags := data.OrganizationalUnits.ElementsAs(ctx, &list, false); diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			if len(list) != len(tt.want) {
+				t.Fatalf("got %d organizational units, want %d", len(list), len(tt.want))
+			}
+
+			for _, ou := range list {
+				want := tt.want[ou.Id]
+
+				if got := ptr.ToString(ou.ParentId); got != want[0] {
+					t.Errorf("got parent id %q for %s, want %q", got, ou.Id, want[0])
+				}
+
+				if ou.Path != want[1] {
+					t.Errorf("got path %q for %s, want %q", ou.Path, ou.Id, want[1])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,236 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/awsteam-contrib/terraform-provider-awsteam/internal/sdk/awsteam"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	permissionSetAttrTypes = map[string]attr.Type{
+		"name":     types.StringType,
+		"arn":      types.StringType,
+		"duration": types.StringType,
+	}
+)
+
+var _ datasource.DataSource = &PermissionSetsDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &PermissionSetsDataSource{}
+
+func NewPermissionSetsDataSource() datasource.DataSource {
+	return &PermissionSetsDataSource{}
+}
+
+type PermissionSetsDataSource struct {
+	client *awsteam.Client
+}
+
+type PermissionSetsModel struct {
+	Id             types.String `tfsdk:"id"`
+	Name           types.String `tfsdk:"name"`
+	NameRegex      types.String `tfsdk:"name_regex"`
+	PermissionSets types.List   `tfsdk:"permission_sets"`
+	ByName         types.Map    `tfsdk:"by_name"`
+}
+
+func (d *PermissionSetsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_permission_sets"
+}
+
+func permissionSetAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"name": schema.StringAttribute{
+			MarkdownDescription: "Name of the permission set.",
+			Computed:            true,
+		},
+		"arn": schema.StringAttribute{
+			MarkdownDescription: "ARN of the permission set.",
+			Computed:            true,
+		},
+		"duration": schema.StringAttribute{
+			MarkdownDescription: "The session duration of the permission set in ISO 8601 format, for example `PT1H`.",
+			Computed:            true,
+		},
+	}
+}
+
+func (d *PermissionSetsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Provides a data source for the IAM Identity Center permission sets AWS TEAM can grant.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Permission Sets Identifier. This is a static value of `permission_sets`.",
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Only return the permission set with this exact name.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("name_regex")),
+				},
+			},
+			"name_regex": schema.StringAttribute{
+				MarkdownDescription: "Only return permission sets whose name matches this regular expression. The expression is not anchored, use `^` and `$` to match the whole name.",
+				Optional:            true,
+			},
+			"permission_sets": schema.ListNestedAttribute{
+				MarkdownDescription: "A list of the permission sets in the order returned by AWS TEAM.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: permissionSetAttributes(),
+				},
+			},
+			"by_name": schema.MapNestedAttribute{
+				MarkdownDescription: "The permission sets keyed by name.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: permissionSetAttributes(),
+				},
+			},
+		},
+	}
+}
+
+func (d *PermissionSetsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	meta, ok := req.ProviderData.(*AWSTEAMClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.AWSTEAMClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = meta.Client
+}
+
+func (d *PermissionSetsDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data PermissionSetsModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() || data.NameRegex.IsNull() || data.NameRegex.IsUnknown() {
+		return
+	}
+
+	if _, err := regexp.Compile(data.NameRegex.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("name_regex"),
+			"Invalid Regular Expression",
+			fmt.Sprintf("The value of name_regex is not a valid regular expression: %s", err),
+		)
+	}
+}
+
+func (d *PermissionSetsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data PermissionSetsModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in := &awsteam.GetPermissionsInput{}
+
+	out, err := d.client.GetPermissions(ctx, in)
+
+	if err != nil {
+		resp.Diagnostics.Append(clientErrorDiagnostic("Unable to read permission sets", err))
+		return
+	}
+
+	resp.Diagnostics.Append(data.flatten(out)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "read permission sets data source")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *PermissionSetsModel) flatten(out *awsteam.GetPermissionsOutput) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var nameRegex *regexp.Regexp
+
+	if !d.NameRegex.IsNull() {
+		re, err := regexp.Compile(d.NameRegex.ValueString())
+
+		if err != nil {
+			diags.AddAttributeError(path.Root("name_regex"), "Invalid Regular Expression", err.Error())
+			return diags
+		}
+
+		nameRegex = re
+	}
+
+	elemType := types.ObjectType{AttrTypes: permissionSetAttrTypes}
+	elems := []attr.Value{}
+	byName := map[string]attr.Value{}
+
+	for _, permission := range out.Permissions {
+		if permission == nil || permission.Name == nil {
+			continue
+		}
+
+		name := *permission.Name
+
+		if !d.Name.IsNull() && name != d.Name.ValueString() {
+			continue
+		}
+
+		if nameRegex != nil && !nameRegex.MatchString(name) {
+			continue
+		}
+
+		obj, objDiags := types.ObjectValue(permissionSetAttrTypes, map[string]attr.Value{
+			"name":     types.StringPointerValue(permission.Name),
+			"arn":      types.StringPointerValue(permission.Arn),
+			"duration": types.StringPointerValue(permission.Duration),
+		})
+		diags.Append(objDiags...)
+
+		elems = append(elems, obj)
+		byName[name] = obj
+	}
+
+	if diags.HasError() {
+		return diags
+	}
+
+	list, listDiags := types.ListValue(elemType, elems)
+	diags.Append(listDiags...)
+
+	byNameMap, byNameDiags := types.MapValue(elemType, byName)
+	diags.Append(byNameDiags...)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	d.Id = types.StringValue("permission_sets")
+	d.PermissionSets = list
+	d.ByName = byNameMap
+
+	return diags
+}
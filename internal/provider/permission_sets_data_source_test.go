@@ -0,0 +1,132 @@
+package provider
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/aws/smithy-go/ptr"
+	"github.com/awsteam-contrib/terraform-provider-awsteam/internal/sdk/awsteam"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccPermissionSetsDataSource_basic(t *testing.T) {
+	dataSourceName := "data.awsteam_permission_sets.test"
+
+	// This environment variable should be set to the name of a permission set expected to be returned by the API.
+	expectedNameVar := "AWSTEAM_TESTS_EXPECTED_PERMISSION_SET_NAME"
+	expectedName := os.Getenv(expectedNameVar)
+	if expectedName == "" {
+		t.Skipf("Skipping Permission Sets Tests, Environment variable %s is not set.", expectedNameVar)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPermissionSetsDataSourceConfig(expectedName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "id", "permission_sets"),
+					resource.TestCheckResourceAttr(dataSourceName, "permission_sets.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "permission_sets.0.name", expectedName),
+					resource.TestCheckResourceAttrSet(dataSourceName, "by_name."+expectedName+".arn"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPermissionSetsDataSourceConfig(name string) string {
+	return `
+data "awsteam_permission_sets" "test" {
+  name = "` + name + `"
+}`
+}
+
+func TestPermissionSetsModel_flatten(t *testing.T) {
+	ctx := context.Background()
+	out := &awsteam.GetPermissionsOutput{
+		Permissions: []*awsteam.Permission{
+			{Name: ptr.String("ReadOnly"), Arn: ptr.String("arn:aws:sso:::permissionSet/ssoins-1111111111111111/ps-1111111111111111"), Duration: ptr.String("PT1H")},
+			{Name: ptr.String("ElevatedAdmin"), Arn: ptr.String("arn:aws:sso:::permissionSet/ssoins-1111111111111111/ps-2222222222222222"), Duration: ptr.String("PT8H")},
+			{Name: ptr.String("ElevatedReadOnly"), Arn: ptr.String("arn:aws:sso:::permissionSet/ssoins-1111111111111111/ps-3333333333333333"), Duration: ptr.String("PT2H")},
+		},
+	}
+
+	testCases := map[string]struct {
+		name      types.String
+		nameRegex types.String
+		want      []string
+	}{
+		"all": {
+			name:      types.StringNull(),
+			nameRegex: types.StringNull(),
+			want:      []string{"ReadOnly", "ElevatedAdmin", "ElevatedReadOnly"},
+		},
+		"exact name": {
+			name:      types.StringValue("ReadOnly"),
+			nameRegex: types.StringNull(),
+			want:      []string{"ReadOnly"},
+		},
+		"regex": {
+			name:      types.StringNull(),
+			nameRegex: types.StringValue("ReadOnly$"),
+			want:      []string{"ReadOnly", "ElevatedReadOnly"},
+		},
+		"no match": {
+			name:      types.StringValue("Missing"),
+			nameRegex: types.StringNull(),
+			want:      []string{},
+		},
+	}
+
+	type permissionSet struct {
+		Name     string `tfsdk:"name"`
+		Arn      string `tfsdk:"arn"`
+		Duration string `tfsdk:"duration"`
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			data := PermissionSetsModel{Name: tc.name, NameRegex: tc.nameRegex}
+
+			if diags := data.flatten(out); diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			var list []permissionSet
+			if diags := data.PermissionSets.ElementsAs(ctx, &list, false); diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			var byName map[string]permissionSet
+			if diags := data.ByName.ElementsAs(ctx, &byName, false); diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			if len(list) != len(tc.want) || len(byName) != len(tc.want) {
+				t.Fatalf("got %d permission sets and %d map entries, want %d", len(list), len(byName), len(tc.want))
+			}
+
+			for i, want := range tc.want {
+				if list[i].Name != want {
+					t.Errorf("got %q at %d, want %q", list[i].Name, i, want)
+				}
+
+				if byName[want].Arn == "" || byName[want].Duration == "" {
+					t.Errorf("got %+v for %s", byName[want], want)
+				}
+			}
+		})
+	}
+
+	t.Run("invalid regex", func(t *testing.T) {
+		data := PermissionSetsModel{Name: types.StringNull(), NameRegex: types.StringValue("(")}
+
+		if diags := data.flatten(out); !diags.HasError() {
+			t.Error("expected an error diagnostic, got none")
+		}
+	})
+}
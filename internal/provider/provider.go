@@ -7,13 +7,18 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"sync"
+	"time"
 
 	"github.com/awsteam-contrib/terraform-provider-awsteam/internal/envvar"
 	"github.com/awsteam-contrib/terraform-provider-awsteam/internal/sdk/awsteam"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 )
@@ -22,24 +27,64 @@ const (
 	ProviderName = "awsteam"
 )
 
+// AWSTEAMClient is passed to resources, ephemeral resources and data sources
+// once the provider is configured.
 type AWSTEAMClient struct {
-	Client        *awsteam.Client
-	Config        *awsteam.Config
-	Token         *awsteam.Token
-	GraphEndpoint string
+	Client *awsteam.Client
+
+	// Whether eligibility resources check their accounts, OUs and permission
+	// sets against the data known to TEAM during plan.
+	ValidateEligibilities bool
+
+	lookupOnce sync.Once
+	lookup     *eligibilityLookup
+
+	identityCenterOnce sync.Once
+	identityCenter     *identityCenterLookup
+}
+
+// eligibilityLookup returns the lookup of the accounts, OUs and permission sets
+// known to TEAM, shared by all resources of the provider configuration so each
+// is requested at most once per run.
+func (c *AWSTEAMClient) eligibilityLookup() *eligibilityLookup {
+	c.lookupOnce.Do(func() {
+		c.lookup = newEligibilityLookup(c.Client)
+	})
+
+	return c.lookup
+}
+
+// identityCenterLookup returns the lookup of the IAM Identity Center groups and
+// users, shared by all resources of the provider configuration so each list is
+// requested at most once per run.
+func (c *AWSTEAMClient) identityCenterLookup() *identityCenterLookup {
+	c.identityCenterOnce.Do(func() {
+		c.identityCenter = newIdentityCenterLookup(c.Client)
+	})
+
+	return c.identityCenter
 }
 
 var _ provider.Provider = &AWSTEAMProvider{}
+var _ provider.ProviderWithEphemeralResources = &AWSTEAMProvider{}
 
 type AWSTEAMProvider struct {
 	version string
+
+	// Functions registering middleware on the AWS TEAM client in addition to
+	// the middleware of the provider. There is no provider attribute setting
+	// them; they exist for the tests of this package.
+	apiOptions []func(*awsteam.MiddlewareStack) error
 }
 
 type AWSTEAMProviderModel struct {
-	ClientId      types.String `tfsdk:"client_id"`
-	ClientSecret  types.String `tfsdk:"client_secret"`
-	GraphEndpoint types.String `tfsdk:"graph_endpoint"`
-	TokenEndpoint types.String `tfsdk:"token_endpoint"`
+	ClientId              types.String `tfsdk:"client_id"`
+	ClientSecret          types.String `tfsdk:"client_secret"`
+	GraphEndpoint         types.String `tfsdk:"graph_endpoint"`
+	MaxBackoff            types.Int64  `tfsdk:"max_backoff"`
+	MaxRetries            types.Int64  `tfsdk:"max_retries"`
+	TokenEndpoint         types.String `tfsdk:"token_endpoint"`
+	ValidateEligibilities types.Bool   `tfsdk:"validate_eligibilities"`
 }
 
 func (p *AWSTEAMProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -66,10 +111,28 @@ func (p *AWSTEAMProvider) Schema(ctx context.Context, req provider.SchemaRequest
 				MarkdownDescription: "The graph endpoint for the AWS TEAM deployment. This can also be defined by setting the `AWSTEAM_GRAPH_ENDPOINT` environment variable. Attribute is required when not configured via environment variable.",
 				Optional:            true,
 			},
+			"max_backoff": schema.Int64Attribute{
+				MarkdownDescription: "The maximum number of seconds to wait between two attempts of a retried request. Retries wait exponentially longer with random jitter, up to this value. Defaults to `20`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"max_retries": schema.Int64Attribute{
+				MarkdownDescription: "The maximum number of times a read request is retried when it is throttled or fails with a server error. Requests that modify data are never retried. Set to `0` to disable retries. Defaults to `3`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
 			"token_endpoint": schema.StringAttribute{
 				MarkdownDescription: "The token endpoint for the oath2 authenticator for AWS TEAMS. This can also be defined by setting the `AWSTEAM_TOKEN_ENDPOINT` environment variable. Attribute is required when not configured via environment variable.",
 				Optional:            true,
 			},
+			"validate_eligibilities": schema.BoolAttribute{
+				MarkdownDescription: "Whether to check during plan that the accounts, OUs and permission sets of eligibility resources are known to AWS TEAM under the configured names. A mismatched id and name then fails the plan instead of being stored. Defaults to `false`.",
+				Optional:            true,
+			},
 		},
 	}
 }
@@ -97,18 +160,45 @@ func (p *AWSTEAMProvider) Configure(ctx context.Context, req provider.ConfigureR
 		ClientSecret:  clientSecret,
 		GraphEndpoint: graphEndpoint,
 		TokenEndpoint: TokenEndpoint,
+		APIOptions:    append([]func(*awsteam.MiddlewareStack) error{addLogOperationsMiddleware}, p.apiOptions...),
+	}
+
+	if !data.MaxRetries.IsNull() {
+		config.MaxAttempts = int(data.MaxRetries.ValueInt64()) + 1
+	}
+
+	if !data.MaxBackoff.IsNull() {
+		config.MaxBackoff = time.Duration(data.MaxBackoff.ValueInt64()) * time.Second
 	}
 
-	config.Build(ctx)
+	if err := config.Build(ctx); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Authenticate to AWS TEAM",
+			fmt.Sprintf("Unable to retrieve a token from the token endpoint %s for client id %s, got error: %s", TokenEndpoint, clientId, err),
+		)
+		return
+	}
 
-	meta := config.NewClient(ctx)
+	client, err := config.NewClient(ctx)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Create AWS TEAM Client", fmt.Sprintf("Unable to create the AWS TEAM client, got error: %s", err))
+		return
+	}
+
+	meta := &AWSTEAMClient{
+		Client:                client,
+		ValidateEligibilities: data.ValidateEligibilities.ValueBool(),
+	}
 
 	resp.DataSourceData = meta
 	resp.ResourceData = meta
+	resp.EphemeralResourceData = meta
 }
 
 func (p *AWSTEAMProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
+		NewAccessRequestResource,
 		NewApproversAccountResource,
 		NewApproversOUResource,
 		NewEligibilityGroupResource,
@@ -119,11 +209,25 @@ func (p *AWSTEAMProvider) Resources(ctx context.Context) []func() resource.Resou
 
 func (p *AWSTEAMProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
+		NewAccessEvaluationDataSource,
 		NewAccountsDataSource,
+		NewApproversDataSource,
+		NewEligibilitiesDataSource,
+		NewEligibilityDataSource,
+		NewIdentityCenterGroupsDataSource,
+		NewIdentityCenterUsersDataSource,
+		NewOrganizationalUnitsDataSource,
+		NewPermissionSetsDataSource,
 		NewSettingsDataSource,
 	}
 }
 
+func (p *AWSTEAMProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewElevatedAccessEphemeralResource,
+	}
+}
+
 func New(version string) func() provider.Provider {
 	return func() provider.Provider {
 		return &AWSTEAMProvider{
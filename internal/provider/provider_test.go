@@ -1,10 +1,26 @@
 package provider
 
 import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"os/exec"
+	"strings"
 	"testing"
 
+	"github.com/aws/smithy-go/ptr"
+	"github.com/awsteam-contrib/terraform-provider-awsteam/internal/awsteamtest"
+	"github.com/awsteam-contrib/terraform-provider-awsteam/internal/envvar"
+	"github.com/awsteam-contrib/terraform-provider-awsteam/internal/sdk/awsteam"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
 var testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
@@ -14,3 +30,239 @@ var testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServe
 func testAccPreCheck(t *testing.T) {
 	// We do not currently have any PreChecks
 }
+
+// testAccFaker returns the generator of random test data for t. It is seeded
+// from the test name, so a test generates the same data in every run and its
+// failures can be reproduced. Every test has its own generator, so the data
+// does not depend on other tests running in parallel.
+func testAccFaker(t *testing.T) *gofakeit.Faker {
+	seed := fnv.New64a()
+	_, _ = seed.Write([]byte(t.Name()))
+
+	return gofakeit.New(int64(seed.Sum64()))
+}
+
+// testAccServer is the in-memory TEAM deployment the tests run against, or nil
+// when the environment points them at a real one.
+var testAccServer *awsteamtest.Server
+
+// testAccIdentityCenterUsers returns n IAM Identity Center users for t. The
+// in-memory deployment gets n new users with random names. A real deployment
+// has to provide them as comma separated user_name=user_id pairs in the
+// AWSTEAM_TESTS_USERS environment variable, otherwise t is skipped.
+func testAccIdentityCenterUsers(t *testing.T, faker *gofakeit.Faker, n int) []identityCenterPrincipal {
+	t.Helper()
+
+	users := testAccIdentityCenterPrincipals(t, faker, n, "AWSTEAM_TESTS_USERS")
+
+	if testAccServer != nil {
+		for _, user := range users {
+			testAccServer.AddUsers(&awsteam.IdCUser{UserId: ptr.String(user.Id), UserName: ptr.String(user.Name)})
+		}
+	}
+
+	return users
+}
+
+// testAccIdentityCenterGroups returns n IAM Identity Center groups for t, like
+// testAccIdentityCenterUsers. A real deployment provides them as display_name=
+// group_id pairs in the AWSTEAM_TESTS_GROUPS environment variable.
+func testAccIdentityCenterGroups(t *testing.T, faker *gofakeit.Faker, n int) []identityCenterPrincipal {
+	t.Helper()
+
+	groups := testAccIdentityCenterPrincipals(t, faker, n, "AWSTEAM_TESTS_GROUPS")
+
+	if testAccServer != nil {
+		for _, group := range groups {
+			testAccServer.AddGroups(&awsteam.IdCGroup{GroupId: ptr.String(group.Id), DisplayName: ptr.String(group.Name)})
+		}
+	}
+
+	return groups
+}
+
+func testAccIdentityCenterPrincipals(t *testing.T, faker *gofakeit.Faker, n int, key string) []identityCenterPrincipal {
+	t.Helper()
+
+	principals := make([]identityCenterPrincipal, 0, n)
+
+	if testAccServer != nil {
+		for range n {
+			principals = append(principals, identityCenterPrincipal{Id: faker.UUID(), Name: faker.Email()})
+		}
+
+		return principals
+	}
+
+	for _, pair := range strings.Split(os.Getenv(key), ",") {
+		name, id, ok := strings.Cut(strings.TrimSpace(pair), "=")
+
+		if ok && len(principals) < n {
+			principals = append(principals, identityCenterPrincipal{Id: id, Name: name})
+		}
+	}
+
+	if len(principals) < n {
+		t.Skipf("Skipping test, Environment variable %s does not list %d name=id pairs.", key, n)
+	}
+
+	return principals
+}
+
+// TestMain runs the tests against an in-memory TEAM deployment unless the
+// environment points them at a real one. Acceptance tests then run without
+// TF_ACC whenever a terraform binary is available.
+func TestMain(m *testing.M) {
+	os.Exit(runTests(m))
+}
+
+func runTests(m *testing.M) int {
+	if os.Getenv(envvar.AWSTEAMGraphEndpoint) != "" {
+		return m.Run()
+	}
+
+	server := awsteamtest.NewServer()
+	defer server.Close()
+
+	testAccServer = server
+	config := server.Config()
+
+	for name, value := range map[string]string{
+		envvar.AWSTEAMClientId:      config.ClientId,
+		envvar.AWSTEAMClientSecret:  config.ClientSecret,
+		envvar.AWSTEAMGraphEndpoint: config.GraphEndpoint,
+		envvar.AWSTEAMTokenEndpoint: config.TokenEndpoint,
+	} {
+		if err := os.Setenv(name, value); err != nil {
+			fmt.Fprintf(os.Stderr, "setting %s: %s\n", name, err)
+			return 1
+		}
+	}
+
+	if os.Getenv("TF_ACC") == "" && terraformAvailable() {
+		if err := os.Setenv("TF_ACC", "1"); err != nil {
+			fmt.Fprintf(os.Stderr, "setting TF_ACC: %s\n", err)
+			return 1
+		}
+	}
+
+	return m.Run()
+}
+
+// terraformAvailable reports whether the acceptance tests can find a
+// terraform binary without downloading one.
+func terraformAvailable() bool {
+	if os.Getenv("TF_ACC_TERRAFORM_PATH") != "" {
+		return true
+	}
+
+	_, err := exec.LookPath("terraform")
+
+	return err == nil
+}
+
+func TestProviderConfigure_tokenError(t *testing.T) {
+	ctx := context.Background()
+	clientSecret := "s3cr3t-value-that-must-not-leak"
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"error":"invalid_client","error_description":"Client authentication failed"}`))
+	}))
+	defer server.Close()
+
+	p := New("test")()
+	config := testProviderConfig(ctx, t, p, map[string]string{
+		"client_id":      "test-client",
+		"client_secret":  clientSecret,
+		"graph_endpoint": server.URL + "/graphql",
+		"token_endpoint": server.URL,
+	})
+
+	resp := &provider.ConfigureResponse{}
+	p.Configure(ctx, provider.ConfigureRequest{Config: config}, resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected an error diagnostic, got none")
+	}
+
+	for _, d := range resp.Diagnostics.Errors() {
+		detail := d.Detail()
+
+		if !strings.Contains(detail, "invalid_client") || !strings.Contains(detail, "Client authentication failed") {
+			t.Errorf("diagnostic does not include the OAuth error: %s", detail)
+		}
+
+		if strings.Contains(d.Summary()+detail, clientSecret) {
+			t.Errorf("diagnostic contains the client secret: %s", detail)
+		}
+	}
+
+	if resp.ResourceData != nil || resp.DataSourceData != nil {
+		t.Error("provider data was set after a failed configuration")
+	}
+}
+
+func TestProviderConfigure_fakeServer(t *testing.T) {
+	ctx := context.Background()
+
+	server := awsteamtest.NewServer()
+	defer server.Close()
+
+	testAccServer = server
+	config := server.Config()
+
+	p := New("test")()
+	resp := &provider.ConfigureResponse{}
+	p.Configure(ctx, provider.ConfigureRequest{Config: testProviderConfig(ctx, t, p, map[string]string{
+		"client_id":      config.ClientId,
+		"client_secret":  config.ClientSecret,
+		"graph_endpoint": config.GraphEndpoint,
+		"token_endpoint": config.TokenEndpoint,
+	})}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	meta, ok := resp.ResourceData.(*AWSTEAMClient)
+
+	if !ok {
+		t.Fatalf("expected *AWSTEAMClient resource data, got %T", resp.ResourceData)
+	}
+
+	if _, err := meta.Client.GetAccounts(ctx, &awsteam.GetAccountsInput{}); err != nil {
+		t.Errorf("calling the fake server: %s", err)
+	}
+}
+
+// testProviderConfig builds a provider configuration from values. Attributes
+// that are not in values are null.
+func testProviderConfig(ctx context.Context, t *testing.T, p provider.Provider, values map[string]string) tfsdk.Config {
+	t.Helper()
+
+	schemaResp := &provider.SchemaResponse{}
+	p.Schema(ctx, provider.SchemaRequest{}, schemaResp)
+
+	objectType, ok := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	if !ok {
+		t.Fatal("provider schema is not an object")
+	}
+
+	attrs := map[string]tftypes.Value{}
+
+	for name, attrType := range objectType.AttributeTypes {
+		if v, ok := values[name]; ok {
+			attrs[name] = tftypes.NewValue(attrType, v)
+		} else {
+			attrs[name] = tftypes.NewValue(attrType, nil)
+		}
+	}
+
+	return tfsdk.Config{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(objectType, attrs),
+	}
+}
@@ -150,18 +150,18 @@ func (r *SettingsResource) Configure(ctx context.Context, req resource.Configure
 		return
 	}
 
-	client, ok := req.ProviderData.(*awsteam.Client)
+	meta, ok := req.ProviderData.(*AWSTEAMClient)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *awsteam.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *provider.AWSTEAMClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = client
+	r.client = meta.Client
 }
 
 func (r *SettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -202,7 +202,7 @@ func (r *SettingsResource) Create(ctx context.Context, req resource.CreateReques
 	out, err := r.client.CreateSettings(ctx, in)
 
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create settings, got error: %s", err))
+		resp.Diagnostics.Append(clientErrorDiagnostic("Unable to create settings", err))
 		return
 	}
 
@@ -235,8 +235,14 @@ func (r *SettingsResource) Read(ctx context.Context, req resource.ReadRequest, r
 
 	out, err := r.client.GetSettings(ctx, in)
 
+	if isNotFound(err) {
+		resp.Diagnostics.AddWarning("Read Error", "Received empty Settings. Removing from state.")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read settings, got error: %s", err))
+		resp.Diagnostics.Append(clientErrorDiagnostic("Unable to read settings", err))
 		return
 	}
 
@@ -304,7 +310,7 @@ func (r *SettingsResource) Update(ctx context.Context, req resource.UpdateReques
 		out, err := r.client.UpdateSettings(ctx, in)
 
 		if err != nil {
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read settings, got error: %s", err))
+			resp.Diagnostics.Append(clientErrorDiagnostic("Unable to update settings", err))
 			return
 		}
 
@@ -340,8 +346,8 @@ func (r *SettingsResource) Delete(ctx context.Context, req resource.DeleteReques
 
 	_, err := r.client.DeleteSettings(ctx, in)
 
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read settings, got error: %s", err))
+	if err != nil && !isAlreadyDeleted(err) {
+		resp.Diagnostics.Append(clientErrorDiagnostic("Unable to delete settings", err))
 		return
 	}
 }
@@ -2,19 +2,19 @@ package provider
 
 import (
 	"fmt"
-	"math/rand"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
 func testAccSettingsDataSource_basic(t *testing.T) {
+	faker := testAccFaker(t)
 	resourceName := "awsteam_settings.test"
 	dataSourceName := "data.awsteam_settings.test"
 	teamAdminGroup := "Team-Admin-Group"
 	teamAuditorGroup := "Team-Auditor-Group"
-	duration := rand.Intn(10)
-	expiry := rand.Intn(10)
+	duration := faker.Number(0, 9)
+	expiry := faker.Number(0, 9)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
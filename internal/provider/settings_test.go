@@ -2,7 +2,6 @@ package provider
 
 import (
 	"fmt"
-	"math/rand"
 	"os"
 	"testing"
 
@@ -37,13 +36,14 @@ func TestAccSettings_serial(t *testing.T) {
 }
 
 func testAccSettingsResource_basic(t *testing.T) {
+	faker := testAccFaker(t)
 	resourceName := "awsteam_settings.test"
 	teamAdminGroup1 := "Team-Admin-Group"
 	teamAuditorGroup1 := "Team-Auditor-Group"
 	teamAdminGroup2 := "Team-Admin-Group"
 	teamAuditorGroup2 := "Team-Auditor-Group"
-	duration := rand.Intn(10)
-	expiry := rand.Intn(10)
+	duration := faker.Number(0, 9)
+	expiry := faker.Number(0, 9)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -80,9 +80,10 @@ func testAccSettingsResource_basic(t *testing.T) {
 }
 
 func testAccSettingsResource_duration(t *testing.T) {
+	faker := testAccFaker(t)
 	resourceName := "awsteam_settings.test"
-	duration := rand.Intn(10)
-	duration2 := rand.Intn(10)
+	duration := faker.Number(0, 9)
+	duration2 := faker.Number(0, 9)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
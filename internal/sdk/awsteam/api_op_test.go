@@ -0,0 +1,558 @@
+package awsteam
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/aws/smithy-go/ptr"
+)
+
+// hostileString contains characters that break or change a GraphQL document
+// when they are placed directly into the query text.
+const hostileString = "quote\" back\\slash\nnewline\t} ) { id } mutation Evil { deleteSettings(input: {id: \"settings\"}) { id } } # $input   ünïcødé"
+
+// echoInput answers a mutation with the input variable it received.
+func echoInput(field string) graphqlHandler {
+	return func(t *testing.T, req graphqlRequest) interface{} {
+		assertNotInterpolated(t, req)
+
+		return map[string]interface{}{field: req.Variables["input"]}
+	}
+}
+
+// echoId answers a query with an object holding the id variable it received.
+func echoId(field string) graphqlHandler {
+	return func(t *testing.T, req graphqlRequest) interface{} {
+		assertNotInterpolated(t, req)
+
+		return map[string]interface{}{field: map[string]interface{}{"id": req.Variables["id"]}}
+	}
+}
+
+func assertNotInterpolated(t *testing.T, req graphqlRequest) {
+	t.Helper()
+
+	if strings.Contains(req.Query, "quote") || strings.Contains(req.Query, "Evil") {
+		t.Errorf("user input was written into the query text:\n%s", req.Query)
+	}
+}
+
+func TestCreateApprovers_variables(t *testing.T) {
+	client := newTestClient(t, echoInput("createApprovers"))
+	in := &CreateApproversInput{
+		Id:         ptr.String(hostileString),
+		Name:       ptr.String(hostileString),
+		Type:       ptr.String("Account"),
+		Approvers:  []*string{ptr.String(hostileString)},
+		GroupIds:   []*string{ptr.String(hostileString)},
+		TicketNo:   ptr.String(hostileString),
+		ModifiedBy: ptr.String(hostileString),
+	}
+
+	out, err := client.CreateApprovers(context.Background(), in)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := &Approvers{
+		Id:         in.Id,
+		Name:       in.Name,
+		Type:       in.Type,
+		Approvers:  in.Approvers,
+		GroupIds:   in.GroupIds,
+		TicketNo:   in.TicketNo,
+		ModifiedBy: in.ModifiedBy,
+	}
+
+	if !reflect.DeepEqual(out.Approvers, want) {
+		t.Errorf("got %+v, want %+v", out.Approvers, want)
+	}
+}
+
+func TestUpdateApprovers_variables(t *testing.T) {
+	client := newTestClient(t, echoInput("updateApprovers"))
+	in := &UpdateApproversInput{
+		Id:         ptr.String(hostileString),
+		Name:       ptr.String(hostileString),
+		Type:       ptr.String("OU"),
+		Approvers:  []*string{ptr.String(hostileString), ptr.String("second")},
+		GroupIds:   []*string{ptr.String(hostileString), ptr.String("second")},
+		TicketNo:   ptr.String(hostileString),
+		ModifiedBy: ptr.String(hostileString),
+	}
+
+	out, err := client.UpdateApprovers(context.Background(), in)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := &Approvers{
+		Id:         in.Id,
+		Name:       in.Name,
+		Type:       in.Type,
+		Approvers:  in.Approvers,
+		GroupIds:   in.GroupIds,
+		TicketNo:   in.TicketNo,
+		ModifiedBy: in.ModifiedBy,
+	}
+
+	if !reflect.DeepEqual(out.Approvers, want) {
+		t.Errorf("got %+v, want %+v", out.Approvers, want)
+	}
+}
+
+func TestCreateEligibility_variables(t *testing.T) {
+	client := newTestClient(t, echoInput("createEligibility"))
+	in := &CreateEligibilityInput{
+		Id:               ptr.String(hostileString),
+		Name:             ptr.String(hostileString),
+		Type:             ptr.String("User"),
+		Accounts:         []*EligibilityAccount{{Id: ptr.String("123456789012"), Name: ptr.String(hostileString)}},
+		OUs:              []*EligibilityOU{{Id: ptr.String("ou-abcd-12345678"), Name: ptr.String(hostileString)}},
+		Permissions:      []*EligibilityPermission{{Id: ptr.String(hostileString), Name: ptr.String(hostileString)}},
+		TicketNo:         ptr.String(hostileString),
+		ApprovalRequired: ptr.Bool(true),
+		Duration:         ptr.Int64(8),
+		ModifiedBy:       ptr.String(hostileString),
+	}
+
+	out, err := client.CreateEligibility(context.Background(), in)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := &Eligibility{
+		Id:               in.Id,
+		Name:             in.Name,
+		Type:             in.Type,
+		Accounts:         in.Accounts,
+		OUs:              in.OUs,
+		Permissions:      in.Permissions,
+		TicketNo:         in.TicketNo,
+		ApprovalRequired: in.ApprovalRequired,
+		Duration:         in.Duration,
+		ModifiedBy:       in.ModifiedBy,
+	}
+
+	if !reflect.DeepEqual(out.Eligibility, want) {
+		t.Errorf("got %+v, want %+v", out.Eligibility, want)
+	}
+}
+
+func TestUpdateEligibility_variables(t *testing.T) {
+	client := newTestClient(t, echoInput("updateEligibility"))
+	in := &UpdateEligibilityInput{
+		Id:               ptr.String(hostileString),
+		Name:             ptr.String(hostileString),
+		Type:             ptr.String("Group"),
+		Accounts:         []*EligibilityAccount{{Id: ptr.String("123456789012"), Name: ptr.String(hostileString)}},
+		OUs:              []*EligibilityOU{},
+		Permissions:      []*EligibilityPermission{{Id: ptr.String(hostileString), Name: ptr.String(hostileString)}},
+		TicketNo:         ptr.String(hostileString),
+		ApprovalRequired: ptr.Bool(false),
+		Duration:         ptr.Int64(12),
+		ModifiedBy:       ptr.String(hostileString),
+	}
+
+	out, err := client.UpdateEligibility(context.Background(), in)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := &Eligibility{
+		Id:               in.Id,
+		Name:             in.Name,
+		Type:             in.Type,
+		Accounts:         in.Accounts,
+		OUs:              in.OUs,
+		Permissions:      in.Permissions,
+		TicketNo:         in.TicketNo,
+		ApprovalRequired: in.ApprovalRequired,
+		Duration:         in.Duration,
+		ModifiedBy:       in.ModifiedBy,
+	}
+
+	if !reflect.DeepEqual(out.Eligibility, want) {
+		t.Errorf("got %+v, want %+v", out.Eligibility, want)
+	}
+}
+
+func TestCreateRequest_variables(t *testing.T) {
+	client := newTestClient(t, echoInput("createRequests"))
+	in := &CreateRequestInput{
+		Username:      ptr.String(hostileString),
+		AccountId:     ptr.String("123456789012"),
+		AccountName:   ptr.String(hostileString),
+		Role:          ptr.String(hostileString),
+		RoleId:        ptr.String(hostileString),
+		StartTime:     ptr.String("2026-10-18T10:00:00Z"),
+		Duration:      ptr.Int64(2),
+		Justification: ptr.String(hostileString),
+		TicketNo:      ptr.String(hostileString),
+		Status:        ptr.String("pending"),
+	}
+
+	out, err := client.CreateRequest(context.Background(), in)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := &Request{
+		Username:      in.Username,
+		AccountId:     in.AccountId,
+		AccountName:   in.AccountName,
+		Role:          in.Role,
+		RoleId:        in.RoleId,
+		StartTime:     in.StartTime,
+		Duration:      in.Duration,
+		Justification: in.Justification,
+		TicketNo:      in.TicketNo,
+		Status:        in.Status,
+	}
+
+	if !reflect.DeepEqual(out.Request, want) {
+		t.Errorf("got %+v, want %+v", out.Request, want)
+	}
+}
+
+func TestUpdateRequest_variables(t *testing.T) {
+	client := newTestClient(t, echoInput("updateRequests"))
+	in := &UpdateRequestInput{
+		Id:            ptr.String(hostileString),
+		Status:        ptr.String("revoked"),
+		RevokeComment: ptr.String(hostileString),
+	}
+
+	out, err := client.UpdateRequest(context.Background(), in)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := &Request{
+		Id:            in.Id,
+		Status:        in.Status,
+		RevokeComment: in.RevokeComment,
+	}
+
+	if !reflect.DeepEqual(out.Request, want) {
+		t.Errorf("got %+v, want %+v", out.Request, want)
+	}
+}
+
+func TestCreateSettings_variables(t *testing.T) {
+	client := newTestClient(t, echoInput("createSettings"))
+	in := &CreateSettingsInput{
+		Approval:                  ptr.Bool(true),
+		Comments:                  ptr.Bool(false),
+		Duration:                  ptr.Int64(9),
+		Expiry:                    ptr.Int64(3),
+		SesNotificationsEnabled:   ptr.Bool(true),
+		SnsNotificationsEnabled:   ptr.Bool(false),
+		SlackNotificationsEnabled: ptr.Bool(true),
+		SesSourceEmail:            ptr.String(hostileString),
+		SesSourceArn:              ptr.String(hostileString),
+		SlackToken:                ptr.String(hostileString),
+		TeamAdminGroup:            ptr.String(hostileString),
+		TeamAuditorGroup:          ptr.String(hostileString),
+		TicketNo:                  ptr.Bool(true),
+		ModifiedBy:                ptr.String(hostileString),
+	}
+
+	out, err := client.CreateSettings(context.Background(), in)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := &Settings{
+		Approval:                  in.Approval,
+		Comments:                  in.Comments,
+		Duration:                  in.Duration,
+		Expiry:                    in.Expiry,
+		Id:                        ptr.String(defaultSettingsId),
+		SesNotificationsEnabled:   in.SesNotificationsEnabled,
+		SnsNotificationsEnabled:   in.SnsNotificationsEnabled,
+		SlackNotificationsEnabled: in.SlackNotificationsEnabled,
+		SesSourceEmail:            in.SesSourceEmail,
+		SesSourceArn:              in.SesSourceArn,
+		SlackToken:                in.SlackToken,
+		TeamAdminGroup:            in.TeamAdminGroup,
+		TeamAuditorGroup:          in.TeamAuditorGroup,
+		TicketNo:                  in.TicketNo,
+		ModifiedBy:                in.ModifiedBy,
+	}
+
+	if !reflect.DeepEqual(out.Settings, want) {
+		t.Errorf("got %+v, want %+v", out.Settings, want)
+	}
+
+	if in.Id != nil {
+		t.Errorf("input was modified, Id = %q", *in.Id)
+	}
+}
+
+func TestUpdateSettings_variables(t *testing.T) {
+	client := newTestClient(t, func(t *testing.T, req graphqlRequest) interface{} {
+		input, _ := req.Variables["input"].(map[string]interface{})
+
+		for _, key := range []string{"createdAt", "updatedAt"} {
+			if _, ok := input[key]; ok {
+				t.Errorf("%s must not be sent in UpdateSettingsInput", key)
+			}
+		}
+
+		return echoInput("updateSettings")(t, req)
+	})
+	in := &UpdateSettingsInput{
+		Id:               ptr.String(hostileString),
+		Duration:         ptr.Int64(4),
+		Expiry:           ptr.Int64(1),
+		SesSourceEmail:   ptr.String(hostileString),
+		SlackToken:       ptr.String(hostileString),
+		TeamAdminGroup:   ptr.String(hostileString),
+		TeamAuditorGroup: ptr.String(hostileString),
+		TicketNo:         ptr.Bool(false),
+		ModifiedBy:       ptr.String(hostileString),
+		CreatedAt:        ptr.String("2024-01-01T00:00:00Z"),
+	}
+
+	out, err := client.UpdateSettings(context.Background(), in)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := &Settings{
+		Duration:         in.Duration,
+		Expiry:           in.Expiry,
+		Id:               in.Id,
+		SesSourceEmail:   in.SesSourceEmail,
+		SlackToken:       in.SlackToken,
+		TeamAdminGroup:   in.TeamAdminGroup,
+		TeamAuditorGroup: in.TeamAuditorGroup,
+		TicketNo:         in.TicketNo,
+		ModifiedBy:       in.ModifiedBy,
+	}
+
+	if !reflect.DeepEqual(out.Settings, want) {
+		t.Errorf("got %+v, want %+v", out.Settings, want)
+	}
+}
+
+func TestGetOperations_variables(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("GetApprovers", func(t *testing.T) {
+		client := newTestClient(t, echoId("getApprovers"))
+		out, err := client.GetApprovers(ctx, &GetApproversInput{Id: ptr.String(hostileString)})
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if got := ptr.ToString(out.Approvers.Id); got != hostileString {
+			t.Errorf("got id %q, want %q", got, hostileString)
+		}
+	})
+
+	t.Run("GetEligibility", func(t *testing.T) {
+		client := newTestClient(t, echoId("getEligibility"))
+		out, err := client.GetEligibility(ctx, &GetEligibilityInput{Id: ptr.String(hostileString)})
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if got := ptr.ToString(out.Eligibility.Id); got != hostileString {
+			t.Errorf("got id %q, want %q", got, hostileString)
+		}
+	})
+
+	t.Run("GetOU", func(t *testing.T) {
+		client := newTestClient(t, func(t *testing.T, req graphqlRequest) interface{} {
+			assertNotInterpolated(t, req)
+
+			if req.Variables["id"] != hostileString {
+				t.Errorf("got id variable %v, want %q", req.Variables["id"], hostileString)
+			}
+
+			return map[string]interface{}{"getOU": map[string]interface{}{"Id": "ou-abcd-12345678"}}
+		})
+		out, err := client.GetOU(ctx, &GetOUInput{Id: ptr.String(hostileString)})
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if got := ptr.ToString(out.OU.Id); got != "ou-abcd-12345678" {
+			t.Errorf("got parent id %q, want %q", got, "ou-abcd-12345678")
+		}
+	})
+
+	t.Run("GetRequest", func(t *testing.T) {
+		client := newTestClient(t, echoId("getRequests"))
+		out, err := client.GetRequest(ctx, &GetRequestInput{Id: ptr.String(hostileString)})
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if got := ptr.ToString(out.Request.Id); got != hostileString {
+			t.Errorf("got id %q, want %q", got, hostileString)
+		}
+	})
+
+	t.Run("GetSettings", func(t *testing.T) {
+		client := newTestClient(t, echoId("getSettings"))
+		out, err := client.GetSettings(ctx, &GetSettingsInput{})
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if got := ptr.ToString(out.Settings.Id); got != defaultSettingsId {
+			t.Errorf("got id %q, want %q", got, defaultSettingsId)
+		}
+	})
+}
+
+func TestDeleteOperations_variables(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("DeleteApprovers", func(t *testing.T) {
+		client := newTestClient(t, echoInput("deleteApprovers"))
+		out, err := client.DeleteApprovers(ctx, &DeleteApproversInput{Id: ptr.String(hostileString)})
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if got := ptr.ToString(out.Approvers.Id); got != hostileString {
+			t.Errorf("got id %q, want %q", got, hostileString)
+		}
+	})
+
+	t.Run("DeleteEligibility", func(t *testing.T) {
+		client := newTestClient(t, echoInput("deleteEligibility"))
+		out, err := client.DeleteEligibility(ctx, &DeleteEligibilityInput{Id: ptr.String(hostileString)})
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if got := ptr.ToString(out.Eligibility.Id); got != hostileString {
+			t.Errorf("got id %q, want %q", got, hostileString)
+		}
+	})
+
+	t.Run("DeleteSettings", func(t *testing.T) {
+		client := newTestClient(t, echoInput("deleteSettings"))
+		out, err := client.DeleteSettings(ctx, &DeleteSettingsInput{Id: ptr.String(hostileString)})
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if got := ptr.ToString(out.Settings.Id); got != hostileString {
+			t.Errorf("got id %q, want %q", got, hostileString)
+		}
+	})
+}
+
+func TestGetPermissionsOperations(t *testing.T) {
+	permissions := []interface{}{
+		map[string]interface{}{"Name": "ReadOnly", "Arn": "arn:aws:sso:::permissionSet/ssoins-1111111111111111/ps-1111111111111111", "Duration": "PT1H"},
+		map[string]interface{}{"Name": "Admin", "Arn": "arn:aws:sso:::permissionSet/ssoins-1111111111111111/ps-2222222222222222", "Duration": "PT8H"},
+	}
+
+	want := []*Permission{
+		{Name: ptr.String("ReadOnly"), Arn: ptr.String("arn:aws:sso:::permissionSet/ssoins-1111111111111111/ps-1111111111111111"), Duration: ptr.String("PT1H")},
+		{Name: ptr.String("Admin"), Arn: ptr.String("arn:aws:sso:::permissionSet/ssoins-1111111111111111/ps-2222222222222222"), Duration: ptr.String("PT8H")},
+	}
+
+	respond := func(field string) graphqlHandler {
+		return func(t *testing.T, req graphqlRequest) interface{} {
+			if !strings.Contains(req.Query, field) {
+				t.Errorf("query does not select %s:\n%s", field, req.Query)
+			}
+
+			return map[string]interface{}{field: map[string]interface{}{"permissions": permissions}}
+		}
+	}
+
+	t.Run("GetPermissions", func(t *testing.T) {
+		out, err := newTestClient(t, respond("getPermissions")).GetPermissions(context.Background(), &GetPermissionsInput{})
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if !reflect.DeepEqual(out.Permissions, want) {
+			t.Errorf("got %+v, want %+v", out.Permissions, want)
+		}
+	})
+
+	t.Run("GetMgmtPermissions", func(t *testing.T) {
+		out, err := newTestClient(t, respond("getMgmtPermissions")).GetMgmtPermissions(context.Background(), &GetMgmtPermissionsInput{})
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if !reflect.DeepEqual(out.Permissions, want) {
+			t.Errorf("got %+v, want %+v", out.Permissions, want)
+		}
+	})
+}
+
+func TestGetIdentityCenterOperations(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("GetIdCGroups", func(t *testing.T) {
+		client := newTestClient(t, func(t *testing.T, req graphqlRequest) interface{} {
+			return map[string]interface{}{"getIdCGroups": []interface{}{
+				map[string]interface{}{"GroupId": "d78686b5-bb78-471c-8b2f-817e70e3158b", "DisplayName": "Admins"},
+			}}
+		})
+
+		out, err := client.GetIdCGroups(ctx, &GetIdCGroupsInput{})
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		want := []*IdCGroup{{GroupId: ptr.String("d78686b5-bb78-471c-8b2f-817e70e3158b"), DisplayName: ptr.String("Admins")}}
+
+		if !reflect.DeepEqual(out.Groups, want) {
+			t.Errorf("got %+v, want %+v", out.Groups, want)
+		}
+	})
+
+	t.Run("GetIdCUsers", func(t *testing.T) {
+		client := newTestClient(t, func(t *testing.T, req graphqlRequest) interface{} {
+			return map[string]interface{}{"getUsers": []interface{}{
+				map[string]interface{}{"UserId": "90676d8b-1234-4f0e-a3a6-000000000001", "UserName": "jane", "Email": "jane@contoso.com"},
+			}}
+		})
+
+		out, err := client.GetIdCUsers(ctx, &GetIdCUsersInput{})
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		want := []*IdCUser{{UserId: ptr.String("90676d8b-1234-4f0e-a3a6-000000000001"), UserName: ptr.String("jane"), Email: ptr.String("jane@contoso.com")}}
+
+		if !reflect.DeepEqual(out.Users, want) {
+			t.Errorf("got %+v, want %+v", out.Users, want)
+		}
+	})
+}
@@ -0,0 +1,242 @@
+package awsteam
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"golang.org/x/oauth2"
+)
+
+// tokenRefreshWindow is how long before expiry a token is replaced, so that a
+// request never leaves with a token that expires while it is in flight.
+const tokenRefreshWindow = time.Minute
+
+// timeNow is replaced in tests to move the clock forward.
+var timeNow = time.Now
+
+// fetchToken requests a new client_credentials token from the token endpoint.
+func (config *Config) fetchToken(ctx context.Context) (*Token, error) {
+	form := url.Values{
+		"grant_type":    {"client_credentials"},
+		"scope":         {"api/admin"},
+		"client_id":     {config.ClientId},
+		"client_secret": {config.ClientSecret},
+	}
+
+	authReq, err := http.NewRequestWithContext(ctx, http.MethodPost, config.TokenEndpoint, strings.NewReader(form.Encode()))
+
+	if err != nil {
+		tflog.Error(ctx, "Data provided is invalid. Unable to build request for token endpoint.")
+		return nil, fmt.Errorf("building token request: %w", err)
+	}
+
+	authReq.Header.Add("Content-Type", "application/x-www-form-urlencoded")
+	res, err := config.HTTPClient.Do(authReq)
+
+	if err != nil {
+		tflog.Error(ctx, "Failed to receive token from endpoint.")
+		return nil, fmt.Errorf("requesting token: %w", err)
+	}
+
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+
+	if err != nil {
+		tflog.Error(ctx, "Failed to receive token from endpoint.")
+		return nil, fmt.Errorf("reading token response: %w", err)
+	}
+
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		tflog.Error(ctx, "Token endpoint rejected the request.", map[string]interface{}{"status_code": res.StatusCode})
+		return nil, newTokenError(res.StatusCode, body)
+	}
+
+	token := &Token{}
+	err = json.Unmarshal(body, token)
+
+	if err != nil {
+		tflog.Error(ctx, "Invalid JSON in response. Unmarshalling failed.")
+		return nil, fmt.Errorf("decoding token response: %w", err)
+	}
+
+	if token.AccessToken == "" {
+		return nil, &TokenError{StatusCode: res.StatusCode, Description: "response did not contain an access token"}
+	}
+
+	if token.ExpiresIn > 0 {
+		token.Expiry = timeNow().Add(time.Duration(token.ExpiresIn) * time.Second)
+	}
+
+	tflog.Debug(ctx, "Received token", map[string]interface{}{"expires_in": token.ExpiresIn})
+
+	return token, nil
+}
+
+// TokenError is returned when the token endpoint does not issue a token. Code
+// and Description hold the OAuth2 error and error_description when the
+// endpoint returned them.
+type TokenError struct {
+	StatusCode  int
+	Code        string
+	Description string
+}
+
+func newTokenError(statusCode int, body []byte) *TokenError {
+	e := &TokenError{StatusCode: statusCode}
+
+	var payload struct {
+		Error            string `json:"error"`
+		ErrorDescription string `json:"error_description"`
+	}
+
+	if json.Unmarshal(body, &payload) == nil {
+		e.Code = payload.Error
+		e.Description = payload.ErrorDescription
+	}
+
+	return e
+}
+
+func (e *TokenError) Error() string {
+	msg := "token endpoint returned "
+
+	if e.StatusCode != 0 {
+		msg += fmt.Sprintf("HTTP %d", e.StatusCode)
+	}
+
+	if e.Code != "" {
+		msg += fmt.Sprintf(", error %q", e.Code)
+	}
+
+	if e.Description != "" {
+		msg += fmt.Sprintf(": %s", e.Description)
+	}
+
+	return msg
+}
+
+// tokenSource hands out the configured token and fetches a new one when it is
+// about to expire or after it has been rejected.
+type tokenSource struct {
+	config *Config
+
+	mu    sync.Mutex
+	token *Token
+}
+
+func newTokenSource(config *Config) *tokenSource {
+	return &tokenSource{
+		config: config,
+		token:  config.Token,
+	}
+}
+
+// current returns a token that is valid for at least tokenRefreshWindow,
+// fetching a new one if needed.
+func (s *tokenSource) current(ctx context.Context) (*Token, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token != nil && s.token.AccessToken != "" {
+		if s.token.Expiry.IsZero() || timeNow().Add(tokenRefreshWindow).Before(s.token.Expiry) {
+			return s.token, nil
+		}
+	}
+
+	tflog.Debug(ctx, "Refreshing token")
+	token, err := s.config.fetchToken(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	s.token = token
+	s.config.Token = token
+
+	return token, nil
+}
+
+// invalidate drops token so the next call to current fetches a new one. A
+// token that has already been replaced by a concurrent request is left alone.
+func (s *tokenSource) invalidate(token *Token) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token == token {
+		s.token = nil
+	}
+}
+
+// authTransport adds the bearer token to each request. When a request is
+// rejected with a 401 it is sent once more with a freshly fetched token.
+type authTransport struct {
+	source *tokenSource
+	base   http.RoundTripper
+}
+
+func (t *authTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	token, err := t.source.current(req.Context())
+
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := t.transport().RoundTrip(authorize(req, token))
+
+	if err != nil || res.StatusCode != http.StatusUnauthorized {
+		return res, err
+	}
+
+	if req.Body != nil && req.GetBody == nil {
+		return res, nil
+	}
+
+	tflog.Debug(req.Context(), "Request was unauthorized, retrying with a new token")
+
+	_, _ = io.Copy(io.Discard, res.Body)
+	_ = res.Body.Close()
+
+	t.source.invalidate(token)
+	token, err = t.source.current(req.Context())
+
+	if err != nil {
+		return nil, err
+	}
+
+	retry := req.Clone(req.Context())
+
+	if req.GetBody != nil {
+		retry.Body, err = req.GetBody()
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return t.transport().RoundTrip(authorize(retry, token))
+}
+
+func (t *authTransport) transport() http.RoundTripper {
+	if t.base != nil {
+		return t.base
+	}
+
+	return http.DefaultTransport
+}
+
+// authorize returns a copy of req carrying token in the Authorization header.
+func authorize(req *http.Request, token *Token) *http.Request {
+	authorized := req.Clone(req.Context())
+	(&oauth2.Token{AccessToken: token.AccessToken}).SetAuthHeader(authorized)
+
+	return authorized
+}
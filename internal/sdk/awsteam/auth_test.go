@@ -0,0 +1,167 @@
+package awsteam
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/aws/smithy-go/ptr"
+)
+
+// authServer issues numbered tokens and accepts graph requests made with the
+// tokens listed in valid.
+type authServer struct {
+	*httptest.Server
+
+	issued    atomic.Int32
+	expiresIn int
+	valid     func(token string) bool
+}
+
+func newAuthServer(t *testing.T, expiresIn int, valid func(token string) bool) *authServer {
+	t.Helper()
+
+	s := &authServer{expiresIn: expiresIn, valid: valid}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/oauth2/token", func(w http.ResponseWriter, r *http.Request) {
+		n := s.issued.Add(1)
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"access_token":"token-%d","expires_in":%d,"token_type":"Bearer"}`, n, s.expiresIn)
+	})
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		token := r.Header.Get("Authorization")
+
+		if !s.valid(token) {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"errors":[{"errorType":"UnauthorizedException","message":"Token has expired."}]}`))
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":{"getSettings":{"id":"settings"}}}`))
+	})
+
+	s.Server = httptest.NewServer(mux)
+	t.Cleanup(s.Close)
+
+	return s
+}
+
+func (s *authServer) client(ctx context.Context, t *testing.T) *Client {
+	t.Helper()
+
+	config := &Config{
+		ClientId:      "test-client",
+		ClientSecret:  "test-secret",
+		GraphEndpoint: s.URL + "/graphql",
+		TokenEndpoint: s.URL + "/oauth2/token",
+	}
+
+	if err := config.Build(ctx); err != nil {
+		t.Fatalf("building config: %s", err)
+	}
+
+	client, err := config.NewClient(ctx)
+
+	if err != nil {
+		t.Fatalf("creating client: %s", err)
+	}
+
+	return client
+}
+
+func TestClient_refreshesTokenBeforeExpiry(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	timeNow = func() time.Time { return now }
+	t.Cleanup(func() { timeNow = time.Now })
+
+	var seen []string
+	server := newAuthServer(t, 3600, func(token string) bool {
+		seen = append(seen, token)
+		return true
+	})
+	client := server.client(ctx, t)
+
+	for i := 0; i < 3; i++ {
+		if _, err := client.GetSettings(ctx, &GetSettingsInput{}); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	if got := server.issued.Load(); got != 1 {
+		t.Fatalf("got %d tokens issued while the token was valid, want 1", got)
+	}
+
+	// Move inside the refresh window of the first token.
+	now = now.Add(time.Hour - tokenRefreshWindow/2)
+
+	if _, err := client.GetSettings(ctx, &GetSettingsInput{}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got := server.issued.Load(); got != 2 {
+		t.Fatalf("got %d tokens issued after the token neared expiry, want 2", got)
+	}
+
+	want := []string{"Bearer token-1", "Bearer token-1", "Bearer token-1", "Bearer token-2"}
+
+	if fmt.Sprint(seen) != fmt.Sprint(want) {
+		t.Errorf("got authorization headers %q, want %q", seen, want)
+	}
+}
+
+func TestClient_retriesOnceAfterUnauthorized(t *testing.T) {
+	ctx := context.Background()
+
+	// The token endpoint reports no expiry, so only the 401 triggers a refresh.
+	server := newAuthServer(t, 0, func(token string) bool {
+		return token != "Bearer token-1"
+	})
+	client := server.client(ctx, t)
+
+	out, err := client.GetSettings(ctx, &GetSettingsInput{})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got := ptr.ToString(out.Settings.Id); got != "settings" {
+		t.Errorf("got id %q, want %q", got, "settings")
+	}
+
+	if got := server.issued.Load(); got != 2 {
+		t.Errorf("got %d tokens issued, want 2", got)
+	}
+}
+
+func TestClient_unauthorizedAfterRefresh(t *testing.T) {
+	ctx := context.Background()
+
+	var requests atomic.Int32
+	server := newAuthServer(t, 3600, func(token string) bool {
+		requests.Add(1)
+		return false
+	})
+	client := server.client(ctx, t)
+
+	_, err := client.GetSettings(ctx, &GetSettingsInput{})
+
+	if err == nil {
+		t.Fatal("expected an error, got none")
+	}
+
+	if got := requests.Load(); got != 2 {
+		t.Errorf("got %d graph requests, want 2", got)
+	}
+
+	if got := server.issued.Load(); got != 2 {
+		t.Errorf("got %d tokens issued, want 2", got)
+	}
+}
@@ -1,6 +1,12 @@
 package awsteam
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+
 	"github.com/hasura/go-graphql-client"
 )
 
@@ -8,4 +14,110 @@ type Client struct {
 	GraphEndpoint string
 	GraphClient   *graphql.Client
 	Config        *Config
+
+	// handler passes operations through the middleware stack to send.
+	handler Handler
+}
+
+// invoke passes an operation through the middleware of the client, sends it
+// to the graph endpoint and decodes the returned data into out. Failed
+// responses are returned as one of the typed errors.
+func (client *Client) invoke(ctx context.Context, operation string, query string, variables map[string]interface{}, out interface{}) (*responseMetadata, error) {
+	handler := client.handler
+
+	if handler == nil {
+		handler = HandlerFunc(client.send)
+	}
+
+	in := &OperationInput{
+		Name:      operation,
+		Query:     query,
+		Variables: variables,
+		out:       out,
+	}
+
+	res, err := handler.Handle(ctx, in)
+	meta := &responseMetadata{}
+
+	if res != nil {
+		meta.StatusCode = res.StatusCode
+		meta.RequestID = res.RequestID
+	}
+
+	return meta, err
+}
+
+// send makes a single attempt at an operation. It is the last handler of the
+// middleware stack.
+func (client *Client) send(ctx context.Context, in *OperationInput) (*OperationOutput, error) {
+	meta := &responseMetadata{}
+	ctx = context.WithValue(ctx, responseMetadataKey{}, meta)
+
+	raw, err := client.GraphClient.ExecRaw(ctx, in.Query, in.Variables)
+	res := &OperationOutput{StatusCode: meta.StatusCode, RequestID: meta.RequestID}
+
+	if err != nil {
+		return res, newResponseError(in.Name, meta, err)
+	}
+
+	err = json.Unmarshal(raw, in.out)
+
+	if err != nil {
+		return res, &UnexpectedResponseError{
+			ErrorDetails: ErrorDetails{
+				Operation:  in.Name,
+				RequestID:  meta.RequestID,
+				StatusCode: meta.StatusCode,
+			},
+			Err: err,
+		}
+	}
+
+	res.Result = in.out
+
+	return res, nil
+}
+
+// responseMetadata is captured from the HTTP response of an operation.
+type responseMetadata struct {
+	StatusCode int
+	RequestID  string
+	Body       []byte
+}
+
+type responseMetadataKey struct{}
+
+// captureTransport records the response of requests whose context carries a
+// responseMetadata, so errors can report details the graph client discards.
+type captureTransport struct {
+	next http.RoundTripper
+}
+
+func (t *captureTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	res, err := t.next.RoundTrip(req)
+
+	if err != nil {
+		return res, err
+	}
+
+	meta, ok := req.Context().Value(responseMetadataKey{}).(*responseMetadata)
+
+	if !ok {
+		return res, nil
+	}
+
+	body, err := io.ReadAll(res.Body)
+	_ = res.Body.Close()
+
+	if err != nil {
+		return nil, err
+	}
+
+	meta.StatusCode = res.StatusCode
+	meta.RequestID = res.Header.Get("x-amzn-RequestId")
+	meta.Body = body
+
+	res.Body = io.NopCloser(bytes.NewReader(body))
+
+	return res, nil
 }
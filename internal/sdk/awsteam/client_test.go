@@ -0,0 +1,81 @@
+package awsteam
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// graphqlRequest is the payload the graph client posts to the graph endpoint.
+type graphqlRequest struct {
+	Query     string                 `json:"query"`
+	Variables map[string]interface{} `json:"variables"`
+}
+
+// graphqlHandler returns the value of the "data" member of the response for a request.
+type graphqlHandler func(t *testing.T, req graphqlRequest) interface{}
+
+// newTestClient returns a Client configured against a local token endpoint and
+// a graph endpoint that answers every request with handler.
+func newTestClient(t *testing.T, handler graphqlHandler) *Client {
+	t.Helper()
+
+	return newTestClientWithGraphHandler(t, func(w http.ResponseWriter, r *http.Request) {
+		var req graphqlRequest
+
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("decoding graphql request: %s", err)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"data": handler(t, req)})
+	})
+}
+
+// newTestClientWithGraphHandler returns a Client configured against a local
+// token endpoint and a graph endpoint served by graphHandler. optFns change
+// the config before the client is created.
+func newTestClientWithGraphHandler(t *testing.T, graphHandler http.HandlerFunc, optFns ...func(*Config)) *Client {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/oauth2/token", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"access_token":"test-token","expires_in":3600,"token_type":"Bearer"}`))
+	})
+	mux.HandleFunc("/graphql", graphHandler)
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	ctx := context.Background()
+	config := &Config{
+		ClientId:      "test-client",
+		ClientSecret:  "test-secret",
+		GraphEndpoint: server.URL + "/graphql",
+		MinBackoff:    time.Millisecond,
+		MaxBackoff:    5 * time.Millisecond,
+		TokenEndpoint: server.URL + "/oauth2/token",
+	}
+
+	for _, fn := range optFns {
+		fn(config)
+	}
+
+	if err := config.Build(ctx); err != nil {
+		t.Fatalf("building config: %s", err)
+	}
+
+	client, err := config.NewClient(ctx)
+
+	if err != nil {
+		t.Fatalf("creating client: %s", err)
+	}
+
+	return client
+}
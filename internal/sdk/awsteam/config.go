@@ -2,15 +2,14 @@ package awsteam
 
 import (
 	"context"
-	"encoding/json"
+	"errors"
 	"fmt"
-	"io"
 	"net/http"
-	"strings"
+	"net/url"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hasura/go-graphql-client"
-	"golang.org/x/oauth2"
 )
 
 // The Oath2 token.
@@ -18,10 +17,23 @@ type Token struct {
 	AccessToken string `json:"access_token"`
 	ExpiresIn   int    `json:"expires_in"`
 	TokenType   string `json:"token_type"`
+
+	// The time the token expires, calculated from ExpiresIn when the token
+	// is received. A zero value means the token does not expire.
+	Expiry time.Time `json:"-"`
 }
 
 // A Config provides service configuration for service clients.
 type Config struct {
+	// Functions that add, insert or remove middleware in the stack of the
+	// clients created with NewClient. They are applied in order to a stack
+	// holding the middleware identified by RetryMiddlewareID.
+	//
+	// The SDK is an internal package of the provider, so only code in this
+	// module can register middleware. Users of the provider cannot add their
+	// own.
+	APIOptions []func(*MiddlewareStack) error
+
 	// The Oath2 client id
 	ClientId string
 
@@ -34,9 +46,22 @@ type Config struct {
 	// The graph endpoint where aws team is deployed
 	GraphEndpoint string
 
-	// The HTTPClient the SDK's API clients will use to invoke Graph requests.
+	// The HTTPClient the SDK's API clients will use to request tokens and invoke
+	// Graph requests. Authentication is added on top of its transport.
 	HTTPClient *http.Client
 
+	// The number of times a query is sent before giving up when it is throttled
+	// or fails with a server error. Mutations are never retried. Zero means
+	// DefaultMaxAttempts; one disables retries.
+	MaxAttempts int
+
+	// The longest time to wait between two attempts. Zero means DefaultMaxBackoff.
+	MaxBackoff time.Duration
+
+	// The time to wait before the first retry. Later retries wait exponentially
+	// longer, up to MaxBackoff. Zero means DefaultMinBackoff.
+	MinBackoff time.Duration
+
 	// The Oath2 token to be used for Bearer Authentication
 	Token *Token
 
@@ -44,59 +69,67 @@ type Config struct {
 	TokenEndpoint string
 }
 
-func (config *Config) Build(ctx context.Context) {
+func (config *Config) Build(ctx context.Context) error {
 	// Configure the AWS TEAM client
 	// First we need to get a token from the oath endpoint
-	authPayload := strings.NewReader(fmt.Sprintf(`grant_type=client_credentials&scope=api%%2Fadmin&client_id=%s&client_secret=%s`, config.ClientId, config.ClientSecret))
+	if config.HTTPClient == nil {
+		config.HTTPClient = &http.Client{}
+	}
 
 	tflog.Debug(ctx, "Preparing token request", map[string]interface{}{"token_endpoint": config.TokenEndpoint, "graph_endpoint": config.GraphEndpoint, "client_id": config.ClientId})
-	authClient := &http.Client{}
-	authReq, err := http.NewRequest("POST", config.TokenEndpoint, authPayload)
+	token, err := config.fetchToken(ctx)
 
 	if err != nil {
-		panic(err)
+		return err
 	}
 
-	authReq.Header.Add("Content-Type", "application/x-www-form-urlencoded")
-	res, err := authClient.Do(authReq)
+	// Initiate clients and save token
+	config.GraphClient = &graphql.Client{}
+	config.Token = token
 
-	if err != nil {
-		tflog.Error(ctx, "Data provided is invalid. Unable to build request for token endpoint.")
-		panic(err)
-	}
+	return nil
+}
 
-	defer res.Body.Close()
+func (config *Config) NewClient(ctx context.Context) (*Client, error) {
+	if config.Token == nil {
+		return nil, errors.New("no token available, Build must be called before NewClient")
+	}
 
-	body, err := io.ReadAll(res.Body)
+	endpoint, err := url.Parse(config.GraphEndpoint)
 
 	if err != nil {
-		tflog.Error(ctx, "Failed to receive token from endpoint.")
-		panic(err)
+		return nil, fmt.Errorf("invalid graph endpoint: %w", err)
 	}
 
-	token := &Token{}
+	if endpoint.Scheme == "" || endpoint.Host == "" {
+		return nil, fmt.Errorf("invalid graph endpoint %q: an absolute URL is required", config.GraphEndpoint)
+	}
 
-	err = json.Unmarshal(body, token)
+	if config.HTTPClient == nil {
+		config.HTTPClient = &http.Client{}
+	}
 
-	if err != nil {
-		tflog.Error(ctx, "Invalid JSON in response. Unmarshalling failed.")
-		panic(err)
+	// Returns a configured client. Requests are authenticated with a token
+	// source that refreshes the token before it expires.
+	src := newTokenSource(config)
+
+	httpClient := &http.Client{
+		Transport: &captureTransport{
+			next: &authTransport{
+				source: src,
+				base:   config.HTTPClient.Transport,
+			},
+		},
+		Timeout: config.HTTPClient.Timeout,
 	}
 
-	// Initiate clients and save token
-	config.GraphClient = &graphql.Client{}
-	config.HTTPClient = &http.Client{}
-	config.Token = token
-}
+	stack, err := newMiddlewareStack(config)
 
-func (config *Config) NewClient(ctx context.Context) *Client {
-	// Returns a configured client
-	src := oauth2.StaticTokenSource(
-		&oauth2.Token{AccessToken: config.Token.AccessToken},
-	)
+	if err != nil {
+		return nil, err
+	}
 
-	config.HTTPClient = oauth2.NewClient(ctx, src)
-	config.GraphClient = graphql.NewClient(config.GraphEndpoint, config.HTTPClient)
+	config.GraphClient = graphql.NewClient(config.GraphEndpoint, httpClient)
 
 	client := &Client{
 		Config:        config,
@@ -104,5 +137,7 @@ func (config *Config) NewClient(ctx context.Context) *Client {
 		GraphEndpoint: config.GraphEndpoint,
 	}
 
-	return client
+	client.handler = stack.decorate(HandlerFunc(client.send))
+
+	return client, nil
 }
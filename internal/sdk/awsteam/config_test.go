@@ -0,0 +1,155 @@
+package awsteam
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+const testClientSecret = "s3cr3t-value-that-must-not-leak"
+
+func newTokenEndpoint(t *testing.T, status int, body string) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestConfigBuild_tokenErrors(t *testing.T) {
+	testCases := map[string]struct {
+		status          int
+		body            string
+		wantCode        string
+		wantDescription string
+		wantMessage     string
+	}{
+		"invalid_client": {
+			status:          http.StatusBadRequest,
+			body:            `{"error":"invalid_client","error_description":"Client authentication failed"}`,
+			wantCode:        "invalid_client",
+			wantDescription: "Client authentication failed",
+			wantMessage:     `token endpoint returned HTTP 400, error "invalid_client": Client authentication failed`,
+		},
+		"unauthorized_client without description": {
+			status:      http.StatusBadRequest,
+			body:        `{"error":"unauthorized_client"}`,
+			wantCode:    "unauthorized_client",
+			wantMessage: `token endpoint returned HTTP 400, error "unauthorized_client"`,
+		},
+		"server error with html body": {
+			status:      http.StatusBadGateway,
+			body:        `<html>bad gateway</html>`,
+			wantMessage: `token endpoint returned HTTP 502`,
+		},
+		"ok without access token": {
+			status:          http.StatusOK,
+			body:            `{"token_type":"Bearer"}`,
+			wantDescription: "response did not contain an access token",
+			wantMessage:     `token endpoint returned HTTP 200: response did not contain an access token`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			server := newTokenEndpoint(t, tc.status, tc.body)
+			config := &Config{
+				ClientId:      "test-client",
+				ClientSecret:  testClientSecret,
+				GraphEndpoint: server.URL + "/graphql",
+				TokenEndpoint: server.URL,
+			}
+
+			err := config.Build(context.Background())
+
+			var tokenErr *TokenError
+			if !errors.As(err, &tokenErr) {
+				t.Fatalf("got error %v, want *TokenError", err)
+			}
+
+			if tokenErr.StatusCode != tc.status {
+				t.Errorf("got status code %d, want %d", tokenErr.StatusCode, tc.status)
+			}
+
+			if tokenErr.Code != tc.wantCode {
+				t.Errorf("got code %q, want %q", tokenErr.Code, tc.wantCode)
+			}
+
+			if tokenErr.Description != tc.wantDescription {
+				t.Errorf("got description %q, want %q", tokenErr.Description, tc.wantDescription)
+			}
+
+			if err.Error() != tc.wantMessage {
+				t.Errorf("got message %q, want %q", err.Error(), tc.wantMessage)
+			}
+
+			if strings.Contains(err.Error(), testClientSecret) {
+				t.Errorf("error contains the client secret: %s", err)
+			}
+		})
+	}
+}
+
+func TestConfigBuild_invalidJSON(t *testing.T) {
+	server := newTokenEndpoint(t, http.StatusOK, `{"access_token":`)
+	config := &Config{
+		ClientSecret:  testClientSecret,
+		TokenEndpoint: server.URL,
+	}
+
+	err := config.Build(context.Background())
+
+	if err == nil {
+		t.Fatal("expected an error, got none")
+	}
+
+	if !strings.HasPrefix(err.Error(), "decoding token response") {
+		t.Errorf("unexpected error: %s", err)
+	}
+}
+
+func TestConfigBuild_unreachable(t *testing.T) {
+	server := newTokenEndpoint(t, http.StatusOK, "")
+	server.Close()
+
+	config := &Config{
+		ClientSecret:  testClientSecret,
+		TokenEndpoint: server.URL,
+	}
+
+	err := config.Build(context.Background())
+
+	if err == nil {
+		t.Fatal("expected an error, got none")
+	}
+
+	if strings.Contains(err.Error(), testClientSecret) {
+		t.Errorf("error contains the client secret: %s", err)
+	}
+}
+
+func TestConfigNewClient_errors(t *testing.T) {
+	ctx := context.Background()
+
+	if _, err := (&Config{GraphEndpoint: "https://example.com/graphql"}).NewClient(ctx); err == nil {
+		t.Error("expected an error without a token, got none")
+	}
+
+	config := &Config{
+		GraphEndpoint: "not a url",
+		HTTPClient:    &http.Client{},
+		Token:         &Token{AccessToken: "token"},
+	}
+
+	if _, err := config.NewClient(ctx); err == nil {
+		t.Error("expected an error for a relative graph endpoint, got none")
+	}
+}
@@ -0,0 +1,230 @@
+package awsteam
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// ErrorDetails holds what is known about a failed operation. It is embedded
+// in every error type returned for a GraphQL response.
+type ErrorDetails struct {
+	// The SDK operation that failed, for example "GetEligibility".
+	Operation string
+
+	// The AppSync errorType of the first GraphQL error, for example
+	// "DynamoDB:ConditionalCheckFailedException".
+	ErrorType string
+
+	// The messages of all GraphQL errors in the response.
+	Message string
+
+	// The path of the field the first GraphQL error refers to.
+	Path []string
+
+	// The AppSync request id taken from the x-amzn-RequestId header.
+	RequestID string
+
+	// The HTTP status code of the response.
+	StatusCode int
+}
+
+// Details returns the details of the failed operation.
+func (d ErrorDetails) Details() ErrorDetails {
+	return d
+}
+
+// APIError is implemented by every error returned for a GraphQL response, so
+// callers can read the details without knowing the concrete type.
+type APIError interface {
+	error
+	Details() ErrorDetails
+}
+
+func (d ErrorDetails) format(kind string) string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "operation %s: %s", d.Operation, kind)
+
+	if d.Message != "" {
+		fmt.Fprintf(&b, ": %s", d.Message)
+	}
+
+	var extra []string
+
+	if d.ErrorType != "" {
+		extra = append(extra, "error type: "+d.ErrorType)
+	}
+
+	if len(d.Path) > 0 {
+		extra = append(extra, "path: "+strings.Join(d.Path, "."))
+	}
+
+	if d.StatusCode != 0 && d.StatusCode != http.StatusOK {
+		extra = append(extra, fmt.Sprintf("status code: %d", d.StatusCode))
+	}
+
+	if d.RequestID != "" {
+		extra = append(extra, "request id: "+d.RequestID)
+	}
+
+	if len(extra) > 0 {
+		fmt.Fprintf(&b, " (%s)", strings.Join(extra, ", "))
+	}
+
+	return b.String()
+}
+
+// NotFoundError is returned when the requested item does not exist.
+type NotFoundError struct {
+	ErrorDetails
+}
+
+func (e *NotFoundError) Error() string {
+	return e.format("not found")
+}
+
+// UnauthorizedError is returned when the token is rejected or is not allowed
+// to perform the operation.
+type UnauthorizedError struct {
+	ErrorDetails
+}
+
+func (e *UnauthorizedError) Error() string {
+	return e.format("unauthorized")
+}
+
+// ValidationError is returned when AppSync rejects the request document or
+// its variables.
+type ValidationError struct {
+	ErrorDetails
+}
+
+func (e *ValidationError) Error() string {
+	return e.format("validation failed")
+}
+
+// ConflictError is returned when a DynamoDB condition check fails, for example
+// when creating an item that already exists or updating one that does not.
+type ConflictError struct {
+	ErrorDetails
+}
+
+func (e *ConflictError) Error() string {
+	return e.format("conflict")
+}
+
+// ThrottlingError is returned when AppSync or DynamoDB throttles the request.
+type ThrottlingError struct {
+	ErrorDetails
+}
+
+func (e *ThrottlingError) Error() string {
+	return e.format("throttled")
+}
+
+// UnexpectedResponseError is returned for responses that cannot be decoded or
+// that report an error the SDK does not recognize, including 5xx responses.
+type UnexpectedResponseError struct {
+	ErrorDetails
+
+	// The underlying error, if any.
+	Err error
+}
+
+func (e *UnexpectedResponseError) Error() string {
+	msg := e.format("unexpected response")
+
+	if e.Err != nil && e.Message == "" {
+		msg += ": " + e.Err.Error()
+	}
+
+	return msg
+}
+
+func (e *UnexpectedResponseError) Unwrap() error {
+	return e.Err
+}
+
+// appSyncError is an element of the errors array in an AppSync response.
+type appSyncError struct {
+	ErrorType string        `json:"errorType"`
+	Message   string        `json:"message"`
+	Path      []interface{} `json:"path"`
+}
+
+// newResponseError converts a failed response into one of the typed errors.
+// cause is the error reported by the graph client.
+func newResponseError(operation string, meta *responseMetadata, cause error) error {
+	if meta == nil || meta.StatusCode == 0 {
+		// The request never produced a response, e.g. the connection failed.
+		return fmt.Errorf("operation %s: %w", operation, cause)
+	}
+
+	details := ErrorDetails{
+		Operation:  operation,
+		RequestID:  meta.RequestID,
+		StatusCode: meta.StatusCode,
+	}
+
+	var payload struct {
+		Errors []appSyncError `json:"errors"`
+	}
+
+	_ = json.Unmarshal(meta.Body, &payload)
+
+	if len(payload.Errors) > 0 {
+		first := payload.Errors[0]
+		details.ErrorType = first.ErrorType
+
+		for _, p := range first.Path {
+			details.Path = append(details.Path, fmt.Sprint(p))
+		}
+
+		messages := make([]string, 0, len(payload.Errors))
+		for _, e := range payload.Errors {
+			messages = append(messages, e.Message)
+		}
+
+		details.Message = strings.Join(messages, "; ")
+	}
+
+	errorType := strings.ToLower(details.ErrorType)
+
+	switch {
+	case strings.Contains(errorType, "notfound") && !strings.Contains(errorType, "resourcenotfound"):
+		return &NotFoundError{details}
+	case strings.Contains(errorType, "unauthorized"), strings.Contains(errorType, "accessdenied"),
+		meta.StatusCode == http.StatusUnauthorized, meta.StatusCode == http.StatusForbidden:
+		return &UnauthorizedError{details}
+	case strings.Contains(errorType, "throttl"), strings.Contains(errorType, "provisionedthroughputexceeded"),
+		strings.Contains(errorType, "toomanyrequests"), strings.Contains(errorType, "limitexceeded"),
+		meta.StatusCode == http.StatusTooManyRequests:
+		return &ThrottlingError{details}
+	case strings.Contains(errorType, "conditionalcheckfailed"), strings.Contains(errorType, "transactioncanceled"):
+		return &ConflictError{details}
+	case strings.Contains(errorType, "validation"), strings.Contains(errorType, "badrequest"),
+		strings.Contains(errorType, "malformedhttprequest"), meta.StatusCode == http.StatusBadRequest:
+		return &ValidationError{details}
+	}
+
+	return &UnexpectedResponseError{ErrorDetails: details, Err: cause}
+}
+
+// newNotFoundError is returned by operations that receive null for the item
+// they requested.
+func newNotFoundError(operation string, field string, id string, meta *responseMetadata) error {
+	details := ErrorDetails{
+		Operation: operation,
+		Message:   fmt.Sprintf("no item with id %q", id),
+		Path:      []string{field},
+	}
+
+	if meta != nil {
+		details.RequestID = meta.RequestID
+		details.StatusCode = meta.StatusCode
+	}
+
+	return &NotFoundError{details}
+}
@@ -0,0 +1,200 @@
+package awsteam
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/aws/smithy-go/ptr"
+)
+
+func respond(status int, body string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("x-amzn-RequestId", "11111111-2222-3333-4444-555555555555")
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(body))
+	}
+}
+
+func TestResponseErrors(t *testing.T) {
+	testCases := map[string]struct {
+		status        int
+		body          string
+		target        func() interface{}
+		wantErrorType string
+		wantPath      []string
+		wantMessage   string
+	}{
+		"unauthorized field": {
+			status:        http.StatusOK,
+			body:          `{"data":{"getEligibility":null},"errors":[{"path":["getEligibility"],"data":null,"errorType":"Unauthorized","errorInfo":null,"locations":[{"line":2,"column":3}],"message":"Not Authorized to access getEligibility on type Query"}]}`,
+			target:        func() interface{} { return new(*UnauthorizedError) },
+			wantErrorType: "Unauthorized",
+			wantPath:      []string{"getEligibility"},
+			wantMessage:   "Not Authorized to access getEligibility on type Query",
+		},
+		"expired token": {
+			status:        http.StatusUnauthorized,
+			body:          `{"errors":[{"errorType":"UnauthorizedException","message":"Token has expired."}]}`,
+			target:        func() interface{} { return new(*UnauthorizedError) },
+			wantErrorType: "UnauthorizedException",
+			wantMessage:   "Token has expired.",
+		},
+		"validation": {
+			status:        http.StatusOK,
+			body:          `{"data":null,"errors":[{"path":null,"locations":[{"line":1,"column":32}],"message":"Validation error of type FieldUndefined: Field 'nope' in type 'Eligibility' is undefined @ 'getEligibility/nope'","errorType":"ValidationError"}]}`,
+			target:        func() interface{} { return new(*ValidationError) },
+			wantErrorType: "ValidationError",
+			wantMessage:   "Validation error of type FieldUndefined: Field 'nope' in type 'Eligibility' is undefined @ 'getEligibility/nope'",
+		},
+		"conditional check": {
+			status:        http.StatusOK,
+			body:          `{"data":{"createEligibility":null},"errors":[{"path":["createEligibility"],"data":null,"errorType":"DynamoDB:ConditionalCheckFailedException","errorInfo":null,"locations":[{"line":2,"column":3}],"message":"The conditional request failed (Service: DynamoDb, Status Code: 400)"}]}`,
+			target:        func() interface{} { return new(*ConflictError) },
+			wantErrorType: "DynamoDB:ConditionalCheckFailedException",
+			wantPath:      []string{"createEligibility"},
+			wantMessage:   "The conditional request failed (Service: DynamoDb, Status Code: 400)",
+		},
+		"dynamodb throttling": {
+			status:        http.StatusOK,
+			body:          `{"data":{"getEligibility":null},"errors":[{"path":["getEligibility"],"errorType":"DynamoDB:ProvisionedThroughputExceededException","message":"Rate exceeded"}]}`,
+			target:        func() interface{} { return new(*ThrottlingError) },
+			wantErrorType: "DynamoDB:ProvisionedThroughputExceededException",
+			wantPath:      []string{"getEligibility"},
+			wantMessage:   "Rate exceeded",
+		},
+		"http throttling": {
+			status: http.StatusTooManyRequests,
+			body:   `{"message":"Too Many Requests"}`,
+			target: func() interface{} { return new(*ThrottlingError) },
+		},
+		"not found error type": {
+			status:        http.StatusOK,
+			body:          `{"data":{"getEligibility":null},"errors":[{"path":["getEligibility", 0, "accounts"],"errorType":"NotFound","message":"missing"}]}`,
+			target:        func() interface{} { return new(*NotFoundError) },
+			wantErrorType: "NotFound",
+			wantPath:      []string{"getEligibility", "0", "accounts"},
+			wantMessage:   "missing",
+		},
+		"server error": {
+			status: http.StatusBadGateway,
+			body:   `<html>Bad Gateway</html>`,
+			target: func() interface{} { return new(*UnexpectedResponseError) },
+		},
+		"unknown error type": {
+			status:        http.StatusOK,
+			body:          `{"data":null,"errors":[{"errorType":"Lambda:Unhandled","message":"boom"},{"errorType":"Lambda:Unhandled","message":"again"}]}`,
+			target:        func() interface{} { return new(*UnexpectedResponseError) },
+			wantErrorType: "Lambda:Unhandled",
+			wantMessage:   "boom; again",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			client := newTestClientWithGraphHandler(t, respond(tc.status, tc.body))
+
+			_, err := client.GetEligibility(context.Background(), &GetEligibilityInput{Id: ptr.String("user-1")})
+
+			target := tc.target()
+			if !errors.As(err, target) {
+				t.Fatalf("got error %T %v, want %T", err, err, target)
+			}
+
+			var apiErr APIError
+			if !errors.As(err, &apiErr) {
+				t.Fatalf("error %T does not implement APIError", err)
+			}
+
+			details := apiErr.Details()
+
+			if details.Operation != "GetEligibility" {
+				t.Errorf("got operation %q, want GetEligibility", details.Operation)
+			}
+
+			if details.ErrorType != tc.wantErrorType {
+				t.Errorf("got error type %q, want %q", details.ErrorType, tc.wantErrorType)
+			}
+
+			if !reflect.DeepEqual(details.Path, tc.wantPath) {
+				t.Errorf("got path %q, want %q", details.Path, tc.wantPath)
+			}
+
+			if details.Message != tc.wantMessage {
+				t.Errorf("got message %q, want %q", details.Message, tc.wantMessage)
+			}
+
+			if details.RequestID != "11111111-2222-3333-4444-555555555555" {
+				t.Errorf("got request id %q", details.RequestID)
+			}
+
+			if details.StatusCode != tc.status {
+				t.Errorf("got status code %d, want %d", details.StatusCode, tc.status)
+			}
+
+			if !strings.Contains(err.Error(), "request id: 11111111-2222-3333-4444-555555555555") {
+				t.Errorf("error message does not include the request id: %s", err)
+			}
+		})
+	}
+}
+
+func TestResponseErrors_nullItem(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("GetEligibility", func(t *testing.T) {
+		client := newTestClientWithGraphHandler(t, respond(http.StatusOK, `{"data":{"getEligibility":null}}`))
+		_, err := client.GetEligibility(ctx, &GetEligibilityInput{Id: ptr.String("user-1")})
+
+		var notFound *NotFoundError
+		if !errors.As(err, &notFound) {
+			t.Fatalf("got error %v, want *NotFoundError", err)
+		}
+
+		if got := notFound.Path; !reflect.DeepEqual(got, []string{"getEligibility"}) {
+			t.Errorf("got path %q", got)
+		}
+
+		if notFound.RequestID == "" {
+			t.Error("request id is empty")
+		}
+	})
+
+	t.Run("GetApprovers", func(t *testing.T) {
+		client := newTestClientWithGraphHandler(t, respond(http.StatusOK, `{"data":{"getApprovers":null}}`))
+		_, err := client.GetApprovers(ctx, &GetApproversInput{Id: ptr.String("123456789012")})
+
+		var notFound *NotFoundError
+		if !errors.As(err, &notFound) {
+			t.Fatalf("got error %v, want *NotFoundError", err)
+		}
+	})
+
+	t.Run("GetSettings", func(t *testing.T) {
+		client := newTestClientWithGraphHandler(t, respond(http.StatusOK, `{"data":{"getSettings":null}}`))
+		_, err := client.GetSettings(ctx, &GetSettingsInput{})
+
+		var notFound *NotFoundError
+		if !errors.As(err, &notFound) {
+			t.Fatalf("got error %v, want *NotFoundError", err)
+		}
+	})
+}
+
+func TestResponseErrors_undecodableData(t *testing.T) {
+	client := newTestClientWithGraphHandler(t, respond(http.StatusOK, `{"data":{"getAccounts":"not a list"}}`))
+	_, err := client.GetAccounts(context.Background(), &GetAccountsInput{})
+
+	var unexpected *UnexpectedResponseError
+	if !errors.As(err, &unexpected) {
+		t.Fatalf("got error %v, want *UnexpectedResponseError", err)
+	}
+
+	if unexpected.Err == nil {
+		t.Error("underlying decode error is not set")
+	}
+}
@@ -0,0 +1,5 @@
+package awsteam
+
+// The api_op_*.go files are generated from the TEAM schema and the operations
+// in operations.json. See internal/generate/sdkops for the format.
+//go:generate go run ../../generate/sdkops -schema schema.graphql -operations operations.json -types types.go
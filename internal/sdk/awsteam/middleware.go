@@ -0,0 +1,214 @@
+package awsteam
+
+import (
+	"context"
+	"fmt"
+)
+
+// OperationInput is an operation passing through the middleware of a Client.
+type OperationInput struct {
+	// The name of the operation, e.g. "GetEligibility".
+	Name string
+
+	// The GraphQL document sent to the graph endpoint.
+	Query string
+
+	// The variables of the document. Middleware may change them before the
+	// operation is sent.
+	Variables map[string]interface{}
+
+	// out is the value the response data is decoded into.
+	out interface{}
+}
+
+// IsMutation reports whether the operation is a mutation.
+func (in *OperationInput) IsMutation() bool {
+	return !isReadOperation(in.Query)
+}
+
+// OperationOutput is the response to an operation.
+type OperationOutput struct {
+	// The data of the response decoded into the output of the operation. It
+	// is set once the response is decoded.
+	Result interface{}
+
+	// The HTTP status code of the last response, zero when none was received.
+	StatusCode int
+
+	// The request id returned by AppSync.
+	RequestID string
+}
+
+// Handler sends an operation, or passes it on to the next middleware.
+type Handler interface {
+	Handle(ctx context.Context, in *OperationInput) (*OperationOutput, error)
+}
+
+// HandlerFunc is a function used as a Handler.
+type HandlerFunc func(ctx context.Context, in *OperationInput) (*OperationOutput, error)
+
+func (fn HandlerFunc) Handle(ctx context.Context, in *OperationInput) (*OperationOutput, error) {
+	return fn(ctx, in)
+}
+
+// Middleware wraps every operation sent by a Client. It receives the
+// operation before it is sent, calls next to send it, and receives the decoded
+// response or the typed error returned for it. The output may be non-nil
+// along with an error, when a response was received.
+type Middleware interface {
+	// ID identifies the middleware in a MiddlewareStack.
+	ID() string
+
+	HandleOperation(ctx context.Context, in *OperationInput, next Handler) (*OperationOutput, error)
+}
+
+type middlewareFunc struct {
+	id string
+	fn func(ctx context.Context, in *OperationInput, next Handler) (*OperationOutput, error)
+}
+
+// MiddlewareFunc returns a Middleware identified by id that calls fn.
+func MiddlewareFunc(id string, fn func(ctx context.Context, in *OperationInput, next Handler) (*OperationOutput, error)) Middleware {
+	return &middlewareFunc{id: id, fn: fn}
+}
+
+func (m *middlewareFunc) ID() string {
+	return m.id
+}
+
+func (m *middlewareFunc) HandleOperation(ctx context.Context, in *OperationInput, next Handler) (*OperationOutput, error) {
+	return m.fn(ctx, in, next)
+}
+
+// RelativePosition is where a middleware is placed in a MiddlewareStack.
+type RelativePosition int
+
+const (
+	// Before places a middleware so it sees operations before the others.
+	Before RelativePosition = iota
+
+	// After places a middleware so it sees operations after the others.
+	After
+)
+
+// RetryMiddlewareID identifies the middleware that retries queries which are
+// throttled or fail with a server error. Middleware placed after it sees each
+// attempt.
+const RetryMiddlewareID = "Retry"
+
+// MiddlewareStack is the ordered middleware of a Client. The first middleware
+// sees an operation first and its response last.
+type MiddlewareStack struct {
+	middleware []Middleware
+}
+
+// Add places m first or last in the stack.
+func (s *MiddlewareStack) Add(m Middleware, pos RelativePosition) error {
+	if s.index(m.ID()) >= 0 {
+		return fmt.Errorf("middleware %q is already in the stack", m.ID())
+	}
+
+	if pos == Before {
+		s.middleware = append([]Middleware{m}, s.middleware...)
+	} else {
+		s.middleware = append(s.middleware, m)
+	}
+
+	return nil
+}
+
+// Insert places m before or after the middleware identified by relativeTo.
+func (s *MiddlewareStack) Insert(m Middleware, relativeTo string, pos RelativePosition) error {
+	if s.index(m.ID()) >= 0 {
+		return fmt.Errorf("middleware %q is already in the stack", m.ID())
+	}
+
+	i := s.index(relativeTo)
+
+	if i < 0 {
+		return fmt.Errorf("middleware %q is not in the stack", relativeTo)
+	}
+
+	if pos == After {
+		i++
+	}
+
+	s.middleware = append(s.middleware[:i], append([]Middleware{m}, s.middleware[i:]...)...)
+
+	return nil
+}
+
+// Remove removes the middleware identified by id and returns it.
+func (s *MiddlewareStack) Remove(id string) (Middleware, error) {
+	i := s.index(id)
+
+	if i < 0 {
+		return nil, fmt.Errorf("middleware %q is not in the stack", id)
+	}
+
+	m := s.middleware[i]
+	s.middleware = append(s.middleware[:i], s.middleware[i+1:]...)
+
+	return m, nil
+}
+
+// Get returns the middleware identified by id, if it is in the stack.
+func (s *MiddlewareStack) Get(id string) (Middleware, bool) {
+	if i := s.index(id); i >= 0 {
+		return s.middleware[i], true
+	}
+
+	return nil, false
+}
+
+// List returns the ids of the middleware in the stack, in order.
+func (s *MiddlewareStack) List() []string {
+	ids := make([]string, 0, len(s.middleware))
+
+	for _, m := range s.middleware {
+		ids = append(ids, m.ID())
+	}
+
+	return ids
+}
+
+func (s *MiddlewareStack) index(id string) int {
+	for i, m := range s.middleware {
+		if m.ID() == id {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// decorate returns a Handler passing operations through the stack to h.
+func (s *MiddlewareStack) decorate(h Handler) Handler {
+	for i := len(s.middleware) - 1; i >= 0; i-- {
+		m, next := s.middleware[i], h
+
+		h = HandlerFunc(func(ctx context.Context, in *OperationInput) (*OperationOutput, error) {
+			return m.HandleOperation(ctx, in, next)
+		})
+	}
+
+	return h
+}
+
+// newMiddlewareStack returns the default stack of a Client with the APIOptions
+// of config applied.
+func newMiddlewareStack(config *Config) (*MiddlewareStack, error) {
+	s := &MiddlewareStack{}
+
+	if err := s.Add(&retryMiddleware{config: config}, After); err != nil {
+		return nil, err
+	}
+
+	for _, fn := range config.APIOptions {
+		if err := fn(s); err != nil {
+			return nil, fmt.Errorf("applying API options: %w", err)
+		}
+	}
+
+	return s, nil
+}
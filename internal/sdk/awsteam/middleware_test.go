@@ -0,0 +1,176 @@
+package awsteam
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/aws/smithy-go/ptr"
+)
+
+// recorded is what a recording middleware saw of an operation.
+type recorded struct {
+	id        string
+	name      string
+	variables map[string]interface{}
+	result    interface{}
+	err       error
+}
+
+// recordingMiddleware returns a middleware appending what it sees to seen.
+func recordingMiddleware(id string, seen *[]recorded) Middleware {
+	return MiddlewareFunc(id, func(ctx context.Context, in *OperationInput, next Handler) (*OperationOutput, error) {
+		out, err := next.Handle(ctx, in)
+		r := recorded{id: id, name: in.Name, variables: in.Variables, err: err}
+
+		if out != nil {
+			r.result = out.Result
+		}
+
+		*seen = append(*seen, r)
+
+		return out, err
+	})
+}
+
+func TestMiddlewareStack(t *testing.T) {
+	noop := func(id string) Middleware {
+		return MiddlewareFunc(id, func(ctx context.Context, in *OperationInput, next Handler) (*OperationOutput, error) {
+			return next.Handle(ctx, in)
+		})
+	}
+
+	s := &MiddlewareStack{}
+
+	for _, err := range []error{
+		s.Add(noop("b"), After),
+		s.Add(noop("a"), Before),
+		s.Add(noop("d"), After),
+		s.Insert(noop("c"), "d", Before),
+		s.Insert(noop("e"), "d", After),
+	} {
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	if got, want := s.List(), []string{"a", "b", "c", "d", "e"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	if err := s.Add(noop("a"), After); err == nil {
+		t.Error("expected an error adding a middleware twice")
+	}
+
+	if err := s.Insert(noop("f"), "missing", After); err == nil {
+		t.Error("expected an error inserting relative to a missing middleware")
+	}
+
+	if _, err := s.Remove("c"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if _, ok := s.Get("c"); ok {
+		t.Error("removed middleware is still in the stack")
+	}
+
+	if got, want := s.List(), []string{"a", "b", "d", "e"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestClient_middleware(t *testing.T) {
+	var seen []recorded
+
+	handler, _ := scripted(unavailable, eligibility)
+	client := newTestClientWithGraphHandler(t, handler, func(config *Config) {
+		config.APIOptions = append(config.APIOptions,
+			func(s *MiddlewareStack) error {
+				return s.Add(recordingMiddleware("operation", &seen), Before)
+			},
+			func(s *MiddlewareStack) error {
+				return s.Insert(recordingMiddleware("attempt", &seen), RetryMiddlewareID, After)
+			},
+		)
+	})
+
+	out, err := client.GetEligibility(context.Background(), &GetEligibilityInput{Id: ptr.String("user-1")})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var ids []string
+
+	for _, r := range seen {
+		ids = append(ids, r.id)
+
+		if r.name != "GetEligibility" || r.variables["id"] != "user-1" {
+			t.Errorf("%s: got operation %q with variables %v", r.id, r.name, r.variables)
+		}
+	}
+
+	// The attempt middleware sees the failed attempt and the retry, and the
+	// operation middleware sees the operation once, last.
+	if want := []string{"attempt", "attempt", "operation"}; !reflect.DeepEqual(ids, want) {
+		t.Fatalf("got middleware calls %v, want %v", ids, want)
+	}
+
+	var unexpected *UnexpectedResponseError
+
+	if !errors.As(seen[0].err, &unexpected) || unexpected.StatusCode != http.StatusServiceUnavailable {
+		t.Errorf("expected the first attempt to see the server error, got %v", seen[0].err)
+	}
+
+	if seen[2].result != out {
+		t.Errorf("got result %v, want the output of the operation", seen[2].result)
+	}
+}
+
+func TestClient_middlewareShortCircuit(t *testing.T) {
+	client := newTestClient(t, func(t *testing.T, req graphqlRequest) interface{} {
+		t.Error("the operation was sent")
+		return nil
+	})
+
+	stack := &MiddlewareStack{}
+	err := stack.Add(MiddlewareFunc("deny", func(ctx context.Context, in *OperationInput, next Handler) (*OperationOutput, error) {
+		if in.IsMutation() {
+			return nil, errors.New("mutations are not allowed")
+		}
+
+		return next.Handle(ctx, in)
+	}), After)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	client.handler = stack.decorate(HandlerFunc(client.send))
+
+	_, err = client.DeleteEligibility(context.Background(), &DeleteEligibilityInput{Id: ptr.String("user-1")})
+
+	if err == nil || !strings.Contains(err.Error(), "not allowed") {
+		t.Errorf("expected the middleware error, got %v", err)
+	}
+}
+
+func TestNewClient_apiOptionsError(t *testing.T) {
+	config := &Config{
+		GraphEndpoint: "https://example.com/graphql",
+		Token:         &Token{AccessToken: "token"},
+		APIOptions: []func(*MiddlewareStack) error{
+			func(s *MiddlewareStack) error {
+				_, err := s.Remove("missing")
+				return err
+			},
+		},
+	}
+
+	if _, err := config.NewClient(context.Background()); err == nil {
+		t.Error("expected the API options error")
+	}
+}
@@ -0,0 +1,107 @@
+package awsteam
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Walk calls fn for ou and each of its descendants, depth first with parents
+// before their children. parents holds the ancestors of the visited OU,
+// starting at ou. Walk stops when fn returns false.
+func (ou *OU) Walk(fn func(ou *OU, parents []*OU) bool) {
+	if ou == nil {
+		return
+	}
+
+	ou.walk(nil, fn)
+}
+
+func (ou *OU) walk(parents []*OU, fn func(ou *OU, parents []*OU) bool) bool {
+	if !fn(ou, parents) {
+		return false
+	}
+
+	parents = append(parents[:len(parents):len(parents)], ou)
+
+	for i := range ou.Children {
+		if !ou.Children[i].walk(parents, fn) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// FindById returns the OU in the tree below ou, including ou itself, with the
+// given id, or nil if there is none.
+func (ou *OU) FindById(id string) *OU {
+	path := ou.PathTo(id)
+
+	if path == nil {
+		return nil
+	}
+
+	return path[len(path)-1]
+}
+
+// FindByName returns the OUs in the tree below ou, including ou itself, with
+// the given name. OU names are only unique among siblings, so more than one
+// OU may be returned.
+func (ou *OU) FindByName(name string) []*OU {
+	var found []*OU
+
+	ou.Walk(func(ou *OU, _ []*OU) bool {
+		if ou.Name != nil && *ou.Name == name {
+			found = append(found, ou)
+		}
+
+		return true
+	})
+
+	return found
+}
+
+// PathTo returns the OUs from ou down to the OU with the given id, both
+// included, or nil if the tree below ou contains no such OU.
+func (ou *OU) PathTo(id string) []*OU {
+	var path []*OU
+
+	ou.Walk(func(ou *OU, parents []*OU) bool {
+		if ou.Id != nil && *ou.Id == id {
+			path = append(append(path, parents...), ou)
+			return false
+		}
+
+		return true
+	})
+
+	return path
+}
+
+// decodeOUs decodes the AWSJSON value returned by getOUs. TEAM returns the
+// root of the tree; a list of trees is placed under a root without an id.
+func decodeOUs(value string) (*OU, error) {
+	var raw json.RawMessage
+
+	if err := json.Unmarshal([]byte(value), &raw); err != nil {
+		return nil, fmt.Errorf("decoding ous: %w", err)
+	}
+
+	if len(raw) > 0 && raw[0] == '[' {
+		root := &OU{}
+
+		if err := json.Unmarshal(raw, &root.Children); err != nil {
+			return nil, fmt.Errorf("decoding ous: %w", err)
+		}
+
+		return root, nil
+	}
+
+	root := &OU{}
+
+	if err := json.Unmarshal(raw, root); err != nil {
+		return nil, fmt.Errorf("decoding ous: %w", err)
+	}
+
+	return root, nil
+}
@@ -0,0 +1,155 @@
+package awsteam
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+const testOUs = `{
+	"Id": "r-abcd",
+	"Arn": "arn:aws:organizations::111111111111:root/o-example/r-abcd",
+	"Name": "Root",
+	"Children": [
+		{
+			"Id": "ou-abcd-11111111",
+			"Name": "Workloads",
+			"Children": [
+				{"Id": "ou-abcd-22222222", "Name": "Prod", "Children": []},
+				{"Id": "ou-abcd-33333333", "Name": "Dev", "Children": []}
+			]
+		},
+		{
+			"Id": "ou-abcd-44444444",
+			"Name": "Sandbox",
+			"Children": [
+				{"Id": "ou-abcd-55555555", "Name": "Dev", "Children": []}
+			]
+		}
+	]
+}`
+
+func getTestOUs(t *testing.T, ous interface{}) (*OU, error) {
+	t.Helper()
+
+	client := newTestClient(t, func(t *testing.T, req graphqlRequest) interface{} {
+		return map[string]interface{}{"getOUs": map[string]interface{}{"ous": ous}}
+	})
+
+	out, err := client.GetOUs(context.Background(), &GetOUsInput{})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return out.Root, nil
+}
+
+func ids(ous []*OU) []string {
+	var ids []string
+
+	for _, ou := range ous {
+		ids = append(ids, *ou.Id)
+	}
+
+	return ids
+}
+
+func TestGetOUs(t *testing.T) {
+	root, err := getTestOUs(t, testOUs)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got := *root.Id; got != "r-abcd" {
+		t.Errorf("got root id %q", got)
+	}
+
+	if root.Arn == nil {
+		t.Error("root arn is not set")
+	}
+
+	var visited []string
+
+	root.Walk(func(ou *OU, parents []*OU) bool {
+		visited = append(visited, *ou.Id)
+		return true
+	})
+
+	want := []string{"r-abcd", "ou-abcd-11111111", "ou-abcd-22222222", "ou-abcd-33333333", "ou-abcd-44444444", "ou-abcd-55555555"}
+
+	if !reflect.DeepEqual(visited, want) {
+		t.Errorf("got walk order %v, want %v", visited, want)
+	}
+
+	if ou := root.FindById("ou-abcd-33333333"); ou == nil || *ou.Name != "Dev" {
+		t.Errorf("got %+v for ou-abcd-33333333", ou)
+	}
+
+	if ou := root.FindById("ou-none-00000000"); ou != nil {
+		t.Errorf("got %+v for an unknown id", ou)
+	}
+
+	if got := ids(root.FindByName("Dev")); !reflect.DeepEqual(got, []string{"ou-abcd-33333333", "ou-abcd-55555555"}) {
+		t.Errorf("got %v for name Dev", got)
+	}
+
+	if got := ids(root.PathTo("ou-abcd-22222222")); !reflect.DeepEqual(got, []string{"r-abcd", "ou-abcd-11111111", "ou-abcd-22222222"}) {
+		t.Errorf("got path %v", got)
+	}
+
+	if got := ids(root.PathTo("r-abcd")); !reflect.DeepEqual(got, []string{"r-abcd"}) {
+		t.Errorf("got path %v for the root", got)
+	}
+
+	if got := root.PathTo("ou-none-00000000"); got != nil {
+		t.Errorf("got path %v for an unknown id", got)
+	}
+}
+
+func TestWalk_stop(t *testing.T) {
+	root, err := getTestOUs(t, testOUs)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var visited int
+
+	root.Walk(func(ou *OU, parents []*OU) bool {
+		visited++
+		return *ou.Id != "ou-abcd-22222222"
+	})
+
+	if visited != 3 {
+		t.Errorf("visited %d OUs, want 3", visited)
+	}
+}
+
+func TestGetOUs_list(t *testing.T) {
+	root, err := getTestOUs(t, `[{"id": "ou-abcd-11111111", "name": "Workloads"}]`)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if root.Id != nil || len(root.Children) != 1 {
+		t.Fatalf("got root %+v", root)
+	}
+
+	if ou := root.FindById("ou-abcd-11111111"); ou == nil {
+		t.Error("ou-abcd-11111111 not found")
+	}
+}
+
+func TestGetOUs_errors(t *testing.T) {
+	if _, err := getTestOUs(t, `{"Id": `); !errors.As(err, new(*UnexpectedResponseError)) {
+		t.Errorf("got error %v for invalid json, want *UnexpectedResponseError", err)
+	}
+
+	if _, err := getTestOUs(t, nil); !errors.As(err, new(*NotFoundError)) {
+		t.Errorf("got error %v for null ous, want *NotFoundError", err)
+	}
+}
@@ -0,0 +1,116 @@
+package awsteam
+
+import (
+	"context"
+	"fmt"
+	"testing"
+)
+
+// pages answers list queries from field with the given pages, chaining them
+// with the tokens "token-1", "token-2" and so on.
+func pages(field string, items ...[]map[string]interface{}) graphqlHandler {
+	return func(t *testing.T, req graphqlRequest) interface{} {
+		page := 0
+
+		if token, ok := req.Variables["nextToken"].(string); ok {
+			if _, err := fmt.Sscanf(token, "token-%d", &page); err != nil {
+				t.Errorf("unexpected next token %q", token)
+			}
+		}
+
+		connection := map[string]interface{}{"items": items[page], "nextToken": nil}
+
+		if page+1 < len(items) {
+			connection["nextToken"] = fmt.Sprintf("token-%d", page+1)
+		}
+
+		return map[string]interface{}{field: connection}
+	}
+}
+
+func TestListEligibilitiesPaginator(t *testing.T) {
+	client := newTestClient(t, pages("listEligibilities",
+		[]map[string]interface{}{{"id": "user-1", "duration": "2"}, {"id": "group-1", "duration": "4"}},
+		[]map[string]interface{}{},
+		[]map[string]interface{}{{"id": "user-2", "duration": "8"}},
+	))
+
+	limit := int32(2)
+	paginator := NewListEligibilitiesPaginator(client, &ListEligibilitiesInput{Limit: &limit})
+
+	var ids []string
+	var calls int
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(context.Background())
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		calls++
+
+		for _, eligibility := range page.Eligibilities {
+			ids = append(ids, *eligibility.Id)
+		}
+	}
+
+	if calls != 3 {
+		t.Errorf("got %d pages, want 3", calls)
+	}
+
+	if fmt.Sprint(ids) != "[user-1 group-1 user-2]" {
+		t.Errorf("got ids %v", ids)
+	}
+
+	if _, err := paginator.NextPage(context.Background()); err == nil {
+		t.Error("expected an error after the last page, got none")
+	}
+}
+
+func TestListApproversPaginator(t *testing.T) {
+	client := newTestClient(t, pages("listApprovers",
+		[]map[string]interface{}{{"id": "123456789012", "type": "Account"}},
+		[]map[string]interface{}{{"id": "ou-abcd-12345678", "type": "OU"}},
+	))
+
+	paginator := NewListApproversPaginator(client, nil)
+
+	var ids []string
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(context.Background())
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		for _, approvers := range page.Approvers {
+			ids = append(ids, *approvers.Id)
+		}
+	}
+
+	if fmt.Sprint(ids) != "[123456789012 ou-abcd-12345678]" {
+		t.Errorf("got ids %v", ids)
+	}
+}
+
+func TestListApproversPaginator_repeatedToken(t *testing.T) {
+	client := newTestClient(t, func(t *testing.T, req graphqlRequest) interface{} {
+		return map[string]interface{}{"listApprovers": map[string]interface{}{"items": []interface{}{}, "nextToken": "same"}}
+	})
+
+	paginator := NewListApproversPaginator(client, nil)
+
+	if _, err := paginator.NextPage(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if _, err := paginator.NextPage(context.Background()); err == nil {
+		t.Fatal("expected an error for a repeated token, got none")
+	}
+
+	if paginator.HasMorePages() {
+		t.Error("paginator still has pages after a repeated token")
+	}
+}
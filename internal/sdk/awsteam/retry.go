@@ -0,0 +1,126 @@
+package awsteam
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// DefaultMaxAttempts is the number of attempts made for an operation that
+	// can be retried when Config.MaxAttempts is not set.
+	DefaultMaxAttempts = 4
+
+	// DefaultMinBackoff is the backoff before the first retry when
+	// Config.MinBackoff is not set.
+	DefaultMinBackoff = 500 * time.Millisecond
+
+	// DefaultMaxBackoff is the longest backoff between two attempts when
+	// Config.MaxBackoff is not set.
+	DefaultMaxBackoff = 20 * time.Second
+)
+
+func (config *Config) maxAttempts() int {
+	if config == nil || config.MaxAttempts <= 0 {
+		return DefaultMaxAttempts
+	}
+
+	return config.MaxAttempts
+}
+
+// backoff returns how long to wait before the next attempt after attempt
+// failed. The delay grows exponentially from MinBackoff up to MaxBackoff and
+// is jittered over its full range, so parallel callers do not retry in step.
+func (config *Config) backoff(attempt int) time.Duration {
+	minBackoff, maxBackoff := DefaultMinBackoff, DefaultMaxBackoff
+
+	if config != nil && config.MinBackoff > 0 {
+		minBackoff = config.MinBackoff
+	}
+
+	if config != nil && config.MaxBackoff > 0 {
+		maxBackoff = config.MaxBackoff
+	}
+
+	ceiling := minBackoff
+
+	for i := 1; i < attempt && ceiling < maxBackoff; i++ {
+		ceiling *= 2
+	}
+
+	if ceiling > maxBackoff {
+		ceiling = maxBackoff
+	}
+
+	return time.Duration(rand.Int64N(int64(ceiling) + 1))
+}
+
+// isReadOperation reports whether query is a query rather than a mutation.
+// Only queries are retried, as a mutation may have been applied even though
+// its response reported an error.
+func isReadOperation(query string) bool {
+	return strings.HasPrefix(strings.TrimSpace(query), "query")
+}
+
+// isRetryable reports whether an operation that failed with err may succeed
+// when it is sent again.
+func isRetryable(err error) bool {
+	var throttling *ThrottlingError
+
+	if errors.As(err, &throttling) {
+		return true
+	}
+
+	var unexpected *UnexpectedResponseError
+
+	return errors.As(err, &unexpected) && unexpected.StatusCode >= http.StatusInternalServerError
+}
+
+// retryMiddleware sends queries again until they succeed, fail with an error
+// that cannot be retried or the attempts allowed by the config are used up.
+type retryMiddleware struct {
+	config *Config
+}
+
+func (m *retryMiddleware) ID() string {
+	return RetryMiddlewareID
+}
+
+func (m *retryMiddleware) HandleOperation(ctx context.Context, in *OperationInput, next Handler) (*OperationOutput, error) {
+	attempts := 1
+
+	if !in.IsMutation() {
+		attempts = m.config.maxAttempts()
+	}
+
+	for attempt := 1; ; attempt++ {
+		out, err := next.Handle(ctx, in)
+
+		if err == nil || attempt >= attempts || !isRetryable(err) {
+			return out, err
+		}
+
+		delay := m.config.backoff(attempt)
+
+		tflog.Debug(ctx, "Retrying operation", map[string]interface{}{
+			"operation": in.Name,
+			"attempt":   attempt,
+			"delay":     delay.String(),
+			"error":     err.Error(),
+		})
+
+		timer := time.NewTimer(delay)
+
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return out, err
+		case <-timer.C:
+		}
+	}
+}
@@ -0,0 +1,200 @@
+package awsteam
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/aws/smithy-go/ptr"
+)
+
+// scriptedResponse is one response of a scripted graph endpoint.
+type scriptedResponse struct {
+	status int
+	body   string
+}
+
+// scripted returns a graph handler that answers the nth request with the nth
+// response and repeats the last response once the script is used up. The
+// returned counter holds the number of requests received.
+func scripted(responses ...scriptedResponse) (http.HandlerFunc, *int32) {
+	var requests int32
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		n := int(atomic.AddInt32(&requests, 1))
+
+		if n > len(responses) {
+			n = len(responses)
+		}
+
+		res := responses[n-1]
+		respond(res.status, res.body)(w, r)
+	}, &requests
+}
+
+var (
+	throttled   = scriptedResponse{http.StatusTooManyRequests, `{"message":"Too Many Requests"}`}
+	unavailable = scriptedResponse{http.StatusServiceUnavailable, `{"message":"Service Unavailable"}`}
+	dynamoDB    = scriptedResponse{http.StatusOK, `{"data":{"getEligibility":null},"errors":[{"path":["getEligibility"],"errorType":"DynamoDB:ProvisionedThroughputExceededException","message":"Rate exceeded"}]}`}
+	eligibility = scriptedResponse{http.StatusOK, `{"data":{"getEligibility":{"id":"user-1","name":"user","type":"User","duration":"4"}}}`}
+)
+
+func TestRetry_succeedsAfterTransientErrors(t *testing.T) {
+	handler, requests := scripted(unavailable, throttled, dynamoDB, eligibility)
+	client := newTestClientWithGraphHandler(t, handler)
+
+	out, err := client.GetEligibility(context.Background(), &GetEligibilityInput{Id: ptr.String("user-1")})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got := ptr.ToString(out.Eligibility.Id); got != "user-1" {
+		t.Errorf("got id %q, want user-1", got)
+	}
+
+	if got := atomic.LoadInt32(requests); got != 4 {
+		t.Errorf("got %d requests, want 4", got)
+	}
+}
+
+func TestRetry_givesUp(t *testing.T) {
+	handler, requests := scripted(unavailable)
+	client := newTestClientWithGraphHandler(t, handler)
+	client.Config.MaxAttempts = 3
+
+	_, err := client.GetEligibility(context.Background(), &GetEligibilityInput{Id: ptr.String("user-1")})
+
+	var unexpected *UnexpectedResponseError
+	if !errors.As(err, &unexpected) {
+		t.Fatalf("got error %v, want *UnexpectedResponseError", err)
+	}
+
+	if unexpected.StatusCode != http.StatusServiceUnavailable {
+		t.Errorf("got status code %d, want 503", unexpected.StatusCode)
+	}
+
+	if got := atomic.LoadInt32(requests); got != 3 {
+		t.Errorf("got %d requests, want 3", got)
+	}
+}
+
+func TestRetry_disabled(t *testing.T) {
+	handler, requests := scripted(throttled, eligibility)
+	client := newTestClientWithGraphHandler(t, handler)
+	client.Config.MaxAttempts = 1
+
+	_, err := client.GetEligibility(context.Background(), &GetEligibilityInput{Id: ptr.String("user-1")})
+
+	var throttling *ThrottlingError
+	if !errors.As(err, &throttling) {
+		t.Fatalf("got error %v, want *ThrottlingError", err)
+	}
+
+	if got := atomic.LoadInt32(requests); got != 1 {
+		t.Errorf("got %d requests, want 1", got)
+	}
+}
+
+func TestRetry_notRetried(t *testing.T) {
+	testCases := map[string]struct {
+		response scriptedResponse
+		call     func(ctx context.Context, client *Client) error
+	}{
+		"mutation throttled": {
+			response: throttled,
+			call: func(ctx context.Context, client *Client) error {
+				_, err := client.DeleteEligibility(ctx, &DeleteEligibilityInput{Id: ptr.String("user-1")})
+				return err
+			},
+		},
+		"mutation server error": {
+			response: unavailable,
+			call: func(ctx context.Context, client *Client) error {
+				_, err := client.DeleteEligibility(ctx, &DeleteEligibilityInput{Id: ptr.String("user-1")})
+				return err
+			},
+		},
+		"validation error": {
+			response: scriptedResponse{http.StatusBadRequest, `{"errors":[{"errorType":"BadRequestException","message":"bad"}]}`},
+			call: func(ctx context.Context, client *Client) error {
+				_, err := client.GetEligibility(ctx, &GetEligibilityInput{Id: ptr.String("user-1")})
+				return err
+			},
+		},
+		"unauthorized": {
+			response: scriptedResponse{http.StatusForbidden, `{"errors":[{"errorType":"UnauthorizedException","message":"denied"}]}`},
+			call: func(ctx context.Context, client *Client) error {
+				_, err := client.GetEligibility(ctx, &GetEligibilityInput{Id: ptr.String("user-1")})
+				return err
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			handler, requests := scripted(tc.response, eligibility)
+			client := newTestClientWithGraphHandler(t, handler)
+
+			if err := tc.call(context.Background(), client); err == nil {
+				t.Fatal("expected an error, got none")
+			}
+
+			if got := atomic.LoadInt32(requests); got != 1 {
+				t.Errorf("got %d requests, want 1", got)
+			}
+		})
+	}
+}
+
+func TestRetry_contextCanceled(t *testing.T) {
+	handler, requests := scripted(throttled)
+	client := newTestClientWithGraphHandler(t, handler)
+	client.Config.MinBackoff = time.Hour
+	client.Config.MaxBackoff = time.Hour
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err := client.GetEligibility(ctx, &GetEligibilityInput{Id: ptr.String("user-1")})
+
+	var throttling *ThrottlingError
+	if !errors.As(err, &throttling) {
+		t.Fatalf("got error %v, want *ThrottlingError", err)
+	}
+
+	if elapsed := time.Since(start); elapsed > 10*time.Second {
+		t.Errorf("waited %s after the context was canceled", elapsed)
+	}
+
+	if got := atomic.LoadInt32(requests); got != 1 {
+		t.Errorf("got %d requests, want 1", got)
+	}
+}
+
+func TestConfigBackoff(t *testing.T) {
+	config := &Config{MinBackoff: 100 * time.Millisecond, MaxBackoff: time.Second}
+
+	for attempt, ceiling := range map[int]time.Duration{
+		1: 100 * time.Millisecond,
+		2: 200 * time.Millisecond,
+		3: 400 * time.Millisecond,
+		4: 800 * time.Millisecond,
+		5: time.Second,
+		9: time.Second,
+	} {
+		for range 100 {
+			if got := config.backoff(attempt); got < 0 || got > ceiling {
+				t.Fatalf("attempt %d: got backoff %s, want at most %s", attempt, got, ceiling)
+			}
+		}
+	}
+
+	if got := (*Config)(nil).backoff(30); got > DefaultMaxBackoff {
+		t.Errorf("got backoff %s, want at most %s", got, DefaultMaxBackoff)
+	}
+}
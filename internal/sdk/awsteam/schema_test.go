@@ -0,0 +1,253 @@
+package awsteam
+
+import (
+	"context"
+	"os"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/aws/smithy-go/ptr"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/validator"
+)
+
+// schemaTypes maps the schema types returned by TEAM to the types.go structs
+// they are decoded into. Nested objects are checked against the type of the
+// struct field holding them.
+var schemaTypes = map[string]reflect.Type{
+	"accounts":    reflect.TypeOf(Account{}),
+	"Approvers":   reflect.TypeOf(Approvers{}),
+	"Eligibility": reflect.TypeOf(Eligibility{}),
+	"IdCGroups":   reflect.TypeOf(IdCGroup{}),
+	"IdCUsers":    reflect.TypeOf(IdCUser{}),
+	"OU":          reflect.TypeOf(OU{}),
+	"Permission":  reflect.TypeOf(Permission{}),
+	"requests":    reflect.TypeOf(Request{}),
+	"Settings":    reflect.TypeOf(Settings{}),
+}
+
+// partialTypes are structs that also decode documents other than the schema
+// type, so operations only have to select some of their fields. OU is the
+// node of the AWSJSON tree returned by getOUs.
+var partialTypes = map[reflect.Type]bool{
+	reflect.TypeOf(OU{}): true,
+}
+
+// sdkOperations calls every operation of the SDK with all inputs set and
+// returns the requests sent to the graph endpoint.
+func sdkOperations(t *testing.T) []graphqlRequest {
+	t.Helper()
+
+	var (
+		mu       sync.Mutex
+		requests []graphqlRequest
+		seen     = map[string]bool{}
+	)
+
+	client := newTestClient(t, func(t *testing.T, req graphqlRequest) interface{} {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if !seen[req.Query] {
+			seen[req.Query] = true
+			requests = append(requests, req)
+		}
+
+		return nil
+	})
+
+	ctx := context.Background()
+	s := ptr.String("value")
+	accounts := []*EligibilityAccount{{Id: s, Name: s}}
+	ous := []*EligibilityOU{{Id: s, Name: s}}
+	permissions := []*EligibilityPermission{{Id: s, Name: s}}
+
+	// The responses are empty, so the errors of operations that treat a
+	// missing item as not found are expected.
+	_, _ = client.CreateApprovers(ctx, &CreateApproversInput{Id: s, Name: s, Type: s, Approvers: []*string{s}, GroupIds: []*string{s}, TicketNo: s, ModifiedBy: s})
+	_, _ = client.GetApprovers(ctx, &GetApproversInput{Id: s})
+	_, _ = client.ListApprovers(ctx, &ListApproversInput{Limit: ptr.Int32(10), NextToken: s})
+	_, _ = client.UpdateApprovers(ctx, &UpdateApproversInput{Id: s, Name: s, Type: s, Approvers: []*string{s}, GroupIds: []*string{s}, TicketNo: s, ModifiedBy: s})
+	_, _ = client.DeleteApprovers(ctx, &DeleteApproversInput{Id: s})
+
+	_, _ = client.CreateEligibility(ctx, &CreateEligibilityInput{Id: s, Name: s, Type: s, Accounts: accounts, OUs: ous, Permissions: permissions, TicketNo: s, ApprovalRequired: ptr.Bool(true), Duration: ptr.Int64(1), ModifiedBy: s})
+	_, _ = client.GetEligibility(ctx, &GetEligibilityInput{Id: s})
+	_, _ = client.ListEligibilities(ctx, &ListEligibilitiesInput{Limit: ptr.Int32(10), NextToken: s})
+	_, _ = client.UpdateEligibility(ctx, &UpdateEligibilityInput{Id: s, Name: s, Type: s, Accounts: accounts, OUs: ous, Permissions: permissions, TicketNo: s, ApprovalRequired: ptr.Bool(true), Duration: ptr.Int64(1), ModifiedBy: s})
+	_, _ = client.DeleteEligibility(ctx, &DeleteEligibilityInput{Id: s})
+
+	_, _ = client.CreateSettings(ctx, &CreateSettingsInput{Approval: ptr.Bool(true), Comments: ptr.Bool(true), Duration: ptr.Int64(1), Expiry: ptr.Int64(1), Id: s, SesNotificationsEnabled: ptr.Bool(true), SnsNotificationsEnabled: ptr.Bool(true), SlackNotificationsEnabled: ptr.Bool(true), SesSourceEmail: s, SesSourceArn: s, SlackToken: s, TeamAdminGroup: s, TeamAuditorGroup: s, TicketNo: ptr.Bool(true), ModifiedBy: s})
+	_, _ = client.GetSettings(ctx, &GetSettingsInput{})
+	_, _ = client.UpdateSettings(ctx, &UpdateSettingsInput{Approval: ptr.Bool(true), Comments: ptr.Bool(true), Duration: ptr.Int64(1), Expiry: ptr.Int64(1), Id: s, SesNotificationsEnabled: ptr.Bool(true), SnsNotificationsEnabled: ptr.Bool(true), SlackNotificationsEnabled: ptr.Bool(true), SesSourceEmail: s, SesSourceArn: s, SlackToken: s, TeamAdminGroup: s, TeamAuditorGroup: s, TicketNo: ptr.Bool(true), ModifiedBy: s})
+	_, _ = client.DeleteSettings(ctx, &DeleteSettingsInput{})
+
+	_, _ = client.CreateRequest(ctx, &CreateRequestInput{Email: s, Username: s, AccountId: s, AccountName: s, Role: s, RoleId: s, StartTime: s, Duration: ptr.Int64(1), Justification: s, TicketNo: s, Status: s})
+	_, _ = client.GetRequest(ctx, &GetRequestInput{Id: s})
+	_, _ = client.UpdateRequest(ctx, &UpdateRequestInput{Id: s, Status: s, Comment: s, Approver: s, ApproverId: s, Revoker: s, RevokerId: s, RevokeComment: s})
+
+	_, _ = client.GetAccounts(ctx, &GetAccountsInput{})
+	_, _ = client.GetIdCGroups(ctx, &GetIdCGroupsInput{})
+	_, _ = client.GetIdCUsers(ctx, &GetIdCUsersInput{})
+	_, _ = client.GetMgmtPermissions(ctx, &GetMgmtPermissionsInput{})
+	_, _ = client.GetOU(ctx, &GetOUInput{Id: s})
+	_, _ = client.GetOUs(ctx, &GetOUsInput{})
+	_, _ = client.GetPermissions(ctx, &GetPermissionsInput{})
+
+	return requests
+}
+
+func loadSchema(t *testing.T) *ast.Schema {
+	t.Helper()
+
+	b, err := os.ReadFile("schema.graphql")
+
+	if err != nil {
+		t.Fatalf("reading schema: %s", err)
+	}
+
+	schema, err := gqlparser.LoadSchema(&ast.Source{Name: "schema.graphql", Input: string(b)})
+
+	if err != nil {
+		t.Fatalf("loading schema: %s", err)
+	}
+
+	return schema
+}
+
+func TestOperations_schema(t *testing.T) {
+	schema := loadSchema(t)
+	requests := sdkOperations(t)
+
+	if len(requests) == 0 {
+		t.Fatal("no operations were sent")
+	}
+
+	for _, req := range requests {
+		doc, errs := gqlparser.LoadQuery(schema, req.Query)
+
+		if len(errs) > 0 {
+			t.Errorf("operation is invalid: %s\n%s", errs, req.Query)
+			continue
+		}
+
+		for _, op := range doc.Operations {
+			t.Run(op.Name, func(t *testing.T) {
+				if _, err := validator.VariableValues(schema, op, req.Variables); err != nil {
+					t.Errorf("variables are invalid: %s", err)
+				}
+
+				checkSelectionSet(t, op.Name, op.SelectionSet, nil)
+			})
+		}
+	}
+}
+
+// checkSelectionSet checks that the fields selected from an object decoded
+// into goType are decoded into fields of goType, and that every field of
+// goType is a field of the schema type. When goType is nil the object is not
+// decoded into a types.go struct, and only its selected fields are followed.
+func checkSelectionSet(t *testing.T, path string, set ast.SelectionSet, goType reflect.Type) {
+	t.Helper()
+
+	tags := jsonFields(goType)
+
+	for _, selection := range set {
+		field, ok := selection.(*ast.Field)
+
+		if !ok {
+			t.Errorf("%s: unexpected %T in the selection set", path, selection)
+			continue
+		}
+
+		fieldPath := path + "." + field.Alias
+		childType := schemaTypes[field.Definition.Type.Name()]
+
+		if goType != nil {
+			goField, ok := tags[strings.ToLower(field.Alias)]
+
+			if !ok {
+				t.Errorf("%s: selected field is not decoded into %s", fieldPath, goType.Name())
+				continue
+			}
+
+			childType = elemType(goField.Type)
+		}
+
+		if len(field.SelectionSet) > 0 {
+			checkSelectionSet(t, fieldPath, field.SelectionSet, childType)
+		}
+	}
+
+	if goType == nil || partialTypes[goType] || len(set) == 0 {
+		return
+	}
+
+	definition := set[0].(*ast.Field).ObjectDefinition
+
+	var unknown []string
+
+	for name, field := range tags {
+		if !hasField(definition, name) {
+			unknown = append(unknown, field.Name)
+		}
+	}
+
+	sort.Strings(unknown)
+
+	if len(unknown) > 0 {
+		t.Errorf("%s: fields of %s are not in the schema type %s: %s", path, goType.Name(), definition.Name, strings.Join(unknown, ", "))
+	}
+}
+
+// hasField reports whether a schema type has a field matching a lower-cased
+// JSON name.
+func hasField(definition *ast.Definition, name string) bool {
+	for _, field := range definition.Fields {
+		if strings.ToLower(field.Name) == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// jsonFields returns the fields of a struct by their lower-cased JSON name,
+// the way encoding/json matches them when decoding.
+func jsonFields(goType reflect.Type) map[string]reflect.StructField {
+	fields := map[string]reflect.StructField{}
+
+	if goType == nil || goType.Kind() != reflect.Struct {
+		return fields
+	}
+
+	for i := 0; i < goType.NumField(); i++ {
+		field := goType.Field(i)
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+
+		if name == "" || name == "-" {
+			continue
+		}
+
+		fields[strings.ToLower(name)] = field
+	}
+
+	return fields
+}
+
+// elemType returns the struct type held by a field, or nil when it does not
+// hold a struct.
+func elemType(t reflect.Type) reflect.Type {
+	for t.Kind() == reflect.Pointer || t.Kind() == reflect.Slice {
+		t = t.Elem()
+	}
+
+	if t.Kind() != reflect.Struct {
+		return nil
+	}
+
+	return t
+}
@@ -47,6 +47,17 @@ type EligibilityPermission struct {
 	Name *string `json:"name"`
 }
 
+type IdCGroup struct {
+	GroupId     *string `json:"GroupId"`
+	DisplayName *string `json:"DisplayName"`
+}
+
+type IdCUser struct {
+	UserId   *string `json:"UserId"`
+	UserName *string `json:"UserName"`
+	Email    *string `json:"Email"`
+}
+
 type OU struct {
 	Id       *string `json:"id"`
 	Arn      *string `json:"arn"`
@@ -55,9 +66,39 @@ type OU struct {
 }
 
 type Permission struct {
-	Name     *string
-	Arn      *string
-	Duration *string
+	Name     *string `json:"Name"`
+	Arn      *string `json:"Arn"`
+	Duration *string `json:"Duration"` // Session duration of the permission set, e.g. "PT1H"
+}
+
+// TEAM stores its settings as a single item with a fixed id.
+const defaultSettingsId = "settings"
+
+type Request struct {
+	Id              *string   `json:"id"`
+	Email           *string   `json:"email"`    // Email of the requesting user
+	Username        *string   `json:"username"` // User name of the requesting user
+	AccountId       *string   `json:"accountId"`
+	AccountName     *string   `json:"accountName"`
+	Role            *string   `json:"role"`   // Permission set name
+	RoleId          *string   `json:"roleId"` // Permission set ARN
+	StartTime       *string   `json:"startTime"`
+	EndTime         *string   `json:"endTime"`
+	Duration        *int64    `json:"duration,string"` // Hours
+	Justification   *string   `json:"justification"`
+	TicketNo        *string   `json:"ticketNo"`
+	Status          *string   `json:"status"` // e.g. "pending", "approved", "in progress"
+	Comment         *string   `json:"comment"`
+	Approver        *string   `json:"approver"`
+	ApproverId      *string   `json:"approverId"`
+	Approvers       []*string `json:"approvers"`    // Names of the groups that can approve
+	ApproverIds     []*string `json:"approver_ids"` // Ids of the groups that can approve
+	Revoker         *string   `json:"revoker"`
+	RevokerId       *string   `json:"revokerId"`
+	RevokeComment   *string   `json:"revokeComment"`
+	SessionDuration *string   `json:"session_duration"`
+	CreatedAt       *string   `json:"createdAt"`
+	UpdatedAt       *string   `json:"updatedAt"`
 }
 
 type Settings struct {